@@ -2,6 +2,7 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -24,6 +25,14 @@ const (
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
+const (
+	sweepLogFormatJSON = "json"
+
+	sweepOutcomeFailed  = "failed"
+	sweepOutcomeSkipped = "skipped"
+	sweepOutcomeSwept   = "swept"
+)
+
 // sweeperAwsClients is a shared cache of regional AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperAwsClients map[string]interface{}
@@ -93,6 +102,7 @@ type testSweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
 	resource *schema.Resource
+	typeName string
 }
 
 func NewTestSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *testSweepResource {
@@ -103,6 +113,57 @@ func NewTestSweepResource(resource *schema.Resource, d *schema.ResourceData, met
 	}
 }
 
+// WithTypeName sets the Terraform resource type name reported in structured sweeper logs.
+func (sr *testSweepResource) WithTypeName(typeName string) *testSweepResource {
+	sr.typeName = typeName
+
+	return sr
+}
+
+type testSweepLogEntry struct {
+	Error        string `json:"error,omitempty"`
+	ID           string `json:"id,omitempty"`
+	Outcome      string `json:"outcome"`
+	Region       string `json:"region,omitempty"`
+	ResourceType string `json:"type"`
+}
+
+// testSweepLogResult emits a structured sweeper result line when enabled via TF_AWS_SWEEP_LOG_FORMAT.
+// Plain logging is left unchanged otherwise.
+func testSweepLogResult(entry testSweepLogEntry) {
+	if os.Getenv(envvar.TfAwsSweepLogFormat) != sweepLogFormatJSON {
+		return
+	}
+
+	b, err := json.Marshal(entry)
+
+	if err != nil {
+		log.Printf("[WARN] error marshaling sweeper log entry: %s", err)
+		return
+	}
+
+	log.New(os.Stderr, "", 0).Println(string(b))
+}
+
+// testSweepMinAge returns the minimum age of resources to sweep, configured via TF_AWS_SWEEP_MIN_AGE.
+// Zero means resources of any age are swept.
+func testSweepMinAge() time.Duration {
+	v := os.Getenv(envvar.TfAwsSweepMinAge)
+
+	if v == "" {
+		return 0
+	}
+
+	minAge, err := time.ParseDuration(v)
+
+	if err != nil {
+		log.Printf("[WARN] Ignoring invalid %s (%s): %s", envvar.TfAwsSweepMinAge, v, err)
+		return 0
+	}
+
+	return minAge
+}
+
 func testSweepResourceOrchestrator(sweepResources []*testSweepResource) error {
 	return testSweepResourceOrchestratorContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
@@ -133,6 +194,21 @@ func testSweepResourceOrchestratorContext(ctx context.Context, sweepResources []
 				err = testAccDeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 			}
 
+			if sweepResource.typeName != "" {
+				entry := testSweepLogEntry{
+					ID:           sweepResource.d.Id(),
+					Outcome:      sweepOutcomeSwept,
+					ResourceType: sweepResource.typeName,
+				}
+
+				if err != nil {
+					entry.Error = err.Error()
+					entry.Outcome = sweepOutcomeFailed
+				}
+
+				testSweepLogResult(entry)
+			}
+
 			return err
 		})
 	}
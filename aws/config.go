@@ -213,8 +213,12 @@ type Config struct {
 	SkipRegionValidation    bool
 	SkipRequestingAccountId bool
 	SkipMetadataApiCheck    bool
+	SkipTagOnCreate         bool
 	S3ForcePathStyle        bool
 
+	ValidateSagemakerModelNames      bool
+	ValidateSagemakerS3OutputBuckets bool
+
 	terraformVersion string
 }
 
@@ -260,6 +264,7 @@ type AWSClient struct {
 	codestarnotificationsconn           *codestarnotifications.CodeStarNotifications
 	cognitoconn                         *cognitoidentity.CognitoIdentity
 	cognitoidpconn                      *cognitoidentityprovider.CognitoIdentityProvider
+	cognitoidpEndpoint                  string
 	configconn                          *configservice.ConfigService
 	connectconn                         *connect.Connect
 	costandusagereportconn              *costandusagereportservice.CostandUsageReportService
@@ -377,6 +382,7 @@ type AWSClient struct {
 	shieldconn                          *shield.Shield
 	signerconn                          *signer.Signer
 	simpledbconn                        *simpledb.SimpleDB
+	skipTagOnCreate                     bool
 	snsconn                             *sns.SNS
 	sqsconn                             *sqs.SQS
 	ssmconn                             *ssm.SSM
@@ -389,6 +395,8 @@ type AWSClient struct {
 	terraformVersion                    string
 	timestreamwriteconn                 *timestreamwrite.TimestreamWrite
 	transferconn                        *transfer.Transfer
+	validateSagemakerModelNames         bool
+	validateSagemakerS3OutputBuckets    bool
 	wafconn                             *waf.WAF
 	wafregionalconn                     *wafregional.WAFRegional
 	wafv2conn                           *wafv2.WAFV2
@@ -412,6 +420,17 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.region, client.dnsSuffix)
 }
 
+// TagsOnCreate returns the tags to send in a resource create request.
+// When the provider is configured with skip_tag_on_create no tags are returned
+// and the resource applies them with a follow-up tagging call instead.
+func (client *AWSClient) TagsOnCreate(tags keyvaluetags.KeyValueTags) keyvaluetags.KeyValueTags {
+	if client.skipTagOnCreate {
+		return nil
+	}
+
+	return tags
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -516,6 +535,7 @@ func (c *Config) Client() (interface{}, error) {
 		codestarnotificationsconn:           codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["codestarnotifications"])})),
 		cognitoconn:                         cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidentity"])})),
 		cognitoidpconn:                      cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cognitoidp"])})),
+		cognitoidpEndpoint:                  c.Endpoints["cognitoidp"],
 		configconn:                          configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["configservice"])})),
 		connectconn:                         connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["connect"])})),
 		costandusagereportconn:              costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["cur"])})),
@@ -627,6 +647,7 @@ func (c *Config) Client() (interface{}, error) {
 		sfnconn:                             sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["stepfunctions"])})),
 		signerconn:                          signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["signer"])})),
 		simpledbconn:                        simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sdb"])})),
+		skipTagOnCreate:                     c.SkipTagOnCreate,
 		snsconn:                             sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sns"])})),
 		sqsconn:                             sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["sqs"])})),
 		ssmconn:                             ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["ssm"])})),
@@ -638,6 +659,8 @@ func (c *Config) Client() (interface{}, error) {
 		terraformVersion:                    c.terraformVersion,
 		timestreamwriteconn:                 timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["timestreamwrite"])})),
 		transferconn:                        transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["transfer"])})),
+		validateSagemakerModelNames:         c.ValidateSagemakerModelNames,
+		validateSagemakerS3OutputBuckets:    c.ValidateSagemakerS3OutputBuckets,
 		wafconn:                             waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["waf"])})),
 		wafregionalconn:                     wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafregional"])})),
 		wafv2conn:                           wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints["wafv2"])})),
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) {
@@ -82,6 +83,39 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientTagsOnCreate(t *testing.T) {
+	tags := keyvaluetags.New(map[string]string{"key1": "value1"})
+
+	testCases := []struct {
+		Name      string
+		AWSClient *AWSClient
+		Expected  keyvaluetags.KeyValueTags
+	}{
+		{
+			Name:      "default",
+			AWSClient: &AWSClient{},
+			Expected:  tags,
+		},
+		{
+			Name: "skip_tag_on_create",
+			AWSClient: &AWSClient{
+				skipTagOnCreate: true,
+			},
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := testCase.AWSClient.TagsOnCreate(tags)
+
+			if !got.Equal(testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
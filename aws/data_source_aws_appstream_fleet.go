@@ -0,0 +1,124 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
+)
+
+func dataSourceAwsAppStreamFleet() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAwsAppStreamFleetRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_capacity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"available": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"desired_instances": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"in_use": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"running": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"fleet_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsAppStreamFleetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*AWSClient).appstreamconn
+
+	name := d.Get("name").(string)
+
+	fleet, err := finder.FleetByName(ctx, conn, name)
+
+	if tfawserr.ErrCodeEquals(err, appstream.ErrCodeResourceNotFoundException) || (err == nil && fleet == nil) {
+		return diag.Errorf("no AppStream Fleet matched name %q; change the search criteria and try again", name)
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading AppStream Fleet (%s): %w", name, err))
+	}
+
+	d.SetId(aws.StringValue(fleet.Name))
+	d.Set("arn", fleet.Arn)
+
+	if err := d.Set("compute_capacity", flattenComputeCapacity(fleet.ComputeCapacityStatus)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting compute_capacity: %w", err))
+	}
+
+	d.Set("fleet_type", fleet.FleetType)
+	d.Set("image_name", fleet.ImageName)
+	d.Set("instance_type", fleet.InstanceType)
+	d.Set("name", fleet.Name)
+	d.Set("state", fleet.State)
+
+	if err := d.Set("vpc_config", flattenVpcConfig(fleet.VpcConfig)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting vpc_config: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package aws
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAwsAppStreamFleetDataSource_basic(t *testing.T) {
+	resourceName := "aws_appstream_fleet.test"
+	dataSourceName := "data.aws_appstream_fleet.test"
+	instanceType := "stream.standard.small"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckHasIAMRole(t, "AmazonAppStreamServiceAccess")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsAppStreamFleetDestroy,
+		ErrorCheck:        testAccErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAppStreamFleetDataSourceConfig(rName, instanceType),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "compute_capacity.#", resourceName, "compute_capacity.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "compute_capacity.0.desired_instances", resourceName, "compute_capacity.0.desired_instances"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compute_capacity.0.available"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compute_capacity.0.running"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_type", resourceName, "fleet_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "image_name", resourceName, "image_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", appstream.FleetStateRunning),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_config.#", resourceName, "vpc_config.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAwsAppStreamFleetDataSource_NonExistent(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		ErrorCheck:        testAccErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsAppStreamFleetDataSourceConfigNonExistent,
+				ExpectError: regexp.MustCompile(`no AppStream Fleet matched`),
+			},
+		},
+	})
+}
+
+func testAccAwsAppStreamFleetDataSourceConfig(name, instanceType string) string {
+	return composeConfig(
+		testAccAwsAppStreamFleetConfig(name, instanceType),
+		`
+data "aws_appstream_fleet" "test" {
+  name = aws_appstream_fleet.test.name
+}
+`)
+}
+
+const testAccAwsAppStreamFleetDataSourceConfigNonExistent = `
+data "aws_appstream_fleet" "test" {
+  name = "tf-acc-test-does-not-exist"
+}
+`
@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func dataSourceAwsCognitoUserPool() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsCognitoUserPoolRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"estimated_number_of_users": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_modified_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mfa_configuration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"user_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).cognitoidpconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	id := d.Get("user_pool_id").(string)
+	userPool, err := finder.UserPoolByID(conn, id)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no Cognito User Pool matched ID (%s); change the search criteria and try again", id)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito User Pool (%s): %w", id, err)
+	}
+
+	d.SetId(aws.StringValue(userPool.Id))
+	d.Set("arn", userPool.Arn)
+	d.Set("creation_date", aws.TimeValue(userPool.CreationDate).Format(time.RFC3339))
+	d.Set("custom_domain", userPool.CustomDomain)
+	d.Set("domain", userPool.Domain)
+	d.Set("endpoint", cognitoUserPoolEndpoint(meta.(*AWSClient), d.Id()))
+	d.Set("estimated_number_of_users", userPool.EstimatedNumberOfUsers)
+	d.Set("last_modified_date", aws.TimeValue(userPool.LastModifiedDate).Format(time.RFC3339))
+	d.Set("mfa_configuration", userPool.MfaConfiguration)
+	d.Set("name", userPool.Name)
+	d.Set("status", userPool.Status)
+	d.Set("user_pool_id", userPool.Id)
+
+	if err := d.Set("tags", keyvaluetags.CognitoidentityKeyValueTags(userPool.UserPoolTags).IgnoreCognito().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSCognitoUserPoolDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_cognito_user_pool.test"
+	resourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		ErrorCheck: testAccErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creation_date", resourceName, "creation_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint", resourceName, "endpoint"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "estimated_number_of_users", resourceName, "estimated_number_of_users"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "mfa_configuration", resourceName, "mfa_configuration"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "user_pool_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPoolDataSource_NonExistent(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		ErrorCheck: testAccErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCognitoUserPoolDataSourceConfigNonExistent,
+				ExpectError: regexp.MustCompile(`no Cognito User Pool matched`),
+			},
+		},
+	})
+}
+
+func testAccAWSCognitoUserPoolDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_cognito_user_pool" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+`, rName)
+}
+
+const testAccAWSCognitoUserPoolDataSourceConfigNonExistent = `
+data "aws_region" "current" {}
+
+data "aws_cognito_user_pool" "test" {
+  user_pool_id = "${data.aws_region.current.name}_AbCdEfGhI"
+}
+`
@@ -0,0 +1,80 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds/finder"
+)
+
+func dataSourceAwsDbProxyDefaultTargetGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsDbProxyDefaultTargetGroupRead,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_pool_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"connection_borrow_timeout": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"init_query": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"max_connections_percent": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_idle_connections_percent": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"session_pinning_filters": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"db_proxy_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRdsIdentifier,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsDbProxyDefaultTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).rdsconn
+
+	dbProxyName := d.Get("db_proxy_name").(string)
+	tg, err := finder.DBProxyDefaultTargetGroupByDBProxyName(conn, dbProxyName)
+
+	if err != nil {
+		return fmt.Errorf("error reading RDS DB Proxy (%s) default target group: %w", dbProxyName, err)
+	}
+
+	d.SetId(dbProxyName)
+	d.Set("arn", tg.TargetGroupArn)
+	d.Set("db_proxy_name", tg.DBProxyName)
+	d.Set("name", tg.TargetGroupName)
+
+	if err := d.Set("connection_pool_config", flattenDbProxyTargetGroupConnectionPoolConfig(tg.ConnectionPoolConfig)); err != nil {
+		return fmt.Errorf("error setting connection_pool_config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSDBProxyDefaultTargetGroupDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_db_proxy_default_target_group.test"
+	resourceName := "aws_db_proxy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, rds.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyDefaultTargetGroupDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccMatchResourceAttrRegionalARN(dataSourceName, "arn", "rds", regexp.MustCompile(`target-group:.+`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_proxy_name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "connection_pool_config.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "connection_pool_config.0.connection_borrow_timeout", "120"),
+					resource.TestCheckResourceAttr(dataSourceName, "connection_pool_config.0.max_connections_percent", "100"),
+					resource.TestCheckResourceAttr(dataSourceName, "connection_pool_config.0.max_idle_connections_percent", "50"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxyDefaultTargetGroupDataSource_NonExistent(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, rds.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSDBProxyDefaultTargetGroupDataSourceConfigNonExistent(rName),
+				ExpectError: regexp.MustCompile(`error reading RDS DB Proxy`),
+			},
+		},
+	})
+}
+
+func testAccAWSDBProxyDefaultTargetGroupDataSourceConfig(rName string) string {
+	return composeConfig(testAccAWSDBProxyDataSourceConfig(rName), `
+data "aws_db_proxy_default_target_group" "test" {
+  db_proxy_name = aws_db_proxy.test.name
+}
+`)
+}
+
+func testAccAWSDBProxyDefaultTargetGroupDataSourceConfigNonExistent(rName string) string {
+	return fmt.Sprintf(`
+data "aws_db_proxy_default_target_group" "test" {
+  db_proxy_name = %[1]q
+}
+`, rName)
+}
@@ -0,0 +1,71 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAwsKinesisFirehoseDeliveryStreams() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsKinesisFirehoseDeliveryStreamsRead,
+
+		Schema: map[string]*schema.Schema{
+			"delivery_stream_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(firehose.DeliveryStreamType_Values(), false),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsKinesisFirehoseDeliveryStreamsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).firehoseconn
+
+	input := &firehose.ListDeliveryStreamsInput{}
+
+	if v, ok := d.GetOk("delivery_stream_type"); ok {
+		input.DeliveryStreamType = aws.String(v.(string))
+	}
+
+	names := make([]string, 0)
+
+	for {
+		output, err := conn.ListDeliveryStreams(input)
+
+		if err != nil {
+			return fmt.Errorf("error listing Kinesis Firehose Delivery Streams: %w", err)
+		}
+
+		if output == nil {
+			break
+		}
+
+		for _, name := range output.DeliveryStreamNames {
+			names = append(names, aws.StringValue(name))
+		}
+
+		if !aws.BoolValue(output.HasMoreDeliveryStreams) || len(output.DeliveryStreamNames) == 0 {
+			break
+		}
+
+		input.ExclusiveStartDeliveryStreamName = output.DeliveryStreamNames[len(output.DeliveryStreamNames)-1]
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceAwsKinesisFirehoseDeliveryStreams_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_kinesis_firehose_delivery_streams.test"
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsKinesisFirehoseDeliveryStreamsConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckResourceAttrGreaterThanValue(dataSourceName, "names.#", "0"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsKinesisFirehoseDeliveryStreams_DeliveryStreamType(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_kinesis_firehose_delivery_streams.test"
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAwsKinesisFirehoseDeliveryStreamsConfigDeliveryStreamType(rName, firehose.DeliveryStreamTypeDirectPut),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "names.*", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAwsKinesisFirehoseDeliveryStreams_DeliveryStreamType_Invalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAwsKinesisFirehoseDeliveryStreamsConfigDeliveryStreamTypeOnly("Invalid"),
+				ExpectError: regexp.MustCompile(`expected delivery_stream_type to be one of`),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAwsKinesisFirehoseDeliveryStreamsConfig(rName string) string {
+	return composeConfig(
+		testAccDataSourceAwsKinesisFirehoseDeliveryStreamConfigBasic(rName), `
+data "aws_kinesis_firehose_delivery_streams" "test" {
+  depends_on = [aws_kinesis_firehose_delivery_stream.test]
+}
+`)
+}
+
+func testAccDataSourceAwsKinesisFirehoseDeliveryStreamsConfigDeliveryStreamType(rName, deliveryStreamType string) string {
+	return composeConfig(
+		testAccDataSourceAwsKinesisFirehoseDeliveryStreamConfigBasic(rName),
+		fmt.Sprintf(`
+data "aws_kinesis_firehose_delivery_streams" "test" {
+  delivery_stream_type = %[1]q
+
+  depends_on = [aws_kinesis_firehose_delivery_stream.test]
+}
+`, deliveryStreamType))
+}
+
+func testAccDataSourceAwsKinesisFirehoseDeliveryStreamsConfigDeliveryStreamTypeOnly(deliveryStreamType string) string {
+	return fmt.Sprintf(`
+data "aws_kinesis_firehose_delivery_streams" "test" {
+  delivery_stream_type = %[1]q
+}
+`, deliveryStreamType)
+}
@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAwsSagemakerEndpointConfigurations() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSagemakerEndpointConfigurationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"creation_times": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAwsSagemakerEndpointConfigurationsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var createdBefore time.Time
+
+	if v, ok := d.GetOk("created_before"); ok {
+		t, err := time.Parse(time.RFC3339, v.(string))
+
+		if err != nil {
+			return fmt.Errorf("error parsing created_before (%s): %w", v.(string), err)
+		}
+
+		createdBefore = t
+	}
+
+	var results []*sagemaker.EndpointConfigSummary
+
+	err := conn.ListEndpointConfigsPages(&sagemaker.ListEndpointConfigsInput{}, func(page *sagemaker.ListEndpointConfigsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, endpointConfig := range page.EndpointConfigs {
+			if endpointConfig == nil {
+				continue
+			}
+
+			if !createdBefore.IsZero() && !aws.TimeValue(endpointConfig.CreationTime).Before(createdBefore) {
+				continue
+			}
+
+			results = append(results, endpointConfig)
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return fmt.Errorf("error listing SageMaker Endpoint Configurations: %w", err)
+	}
+
+	d.SetId(meta.(*AWSClient).region)
+
+	arns := make([]string, 0, len(results))
+	creationTimes := make([]string, 0, len(results))
+	names := make([]string, 0, len(results))
+
+	for _, r := range results {
+		arns = append(arns, aws.StringValue(r.EndpointConfigArn))
+		creationTimes = append(creationTimes, aws.TimeValue(r.CreationTime).Format(time.RFC3339))
+		names = append(names, aws.StringValue(r.EndpointConfigName))
+	}
+
+	if err := d.Set("arns", arns); err != nil {
+		return fmt.Errorf("error setting arns: %w", err)
+	}
+
+	if err := d.Set("creation_times", creationTimes); err != nil {
+		return fmt.Errorf("error setting creation_times: %w", err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %w", err)
+	}
+
+	return nil
+}
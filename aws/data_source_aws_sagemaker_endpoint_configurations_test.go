@@ -0,0 +1,97 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSSagemakerEndpointConfigurationsDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_sagemaker_endpoint_configurations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfigurationsDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckResourceAttrGreaterThanValue(dataSourceName, "names.#", "0"),
+					testCheckResourceAttrGreaterThanValue(dataSourceName, "arns.#", "0"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creation_times.#", dataSourceName, "names.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpointConfigurationsDataSource_createdBefore(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_sagemaker_endpoint_configurations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfigurationsDataSourceConfigCreatedBefore(rName, "2000-01-01T00:00:00Z"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpointConfigurationsDataSource_createdBeforeInvalid(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSagemakerEndpointConfigurationsDataSourceConfigCreatedBeforeOnly("2021-01-01"),
+				ExpectError: regexp.MustCompile(`expected "created_before" to be a valid RFC3339 date`),
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerEndpointConfigurationsDataSourceConfig(rName string) string {
+	return composeConfig(
+		testAccSagemakerEndpointConfigurationConfig_Basic(rName), `
+data "aws_sagemaker_endpoint_configurations" "test" {
+  depends_on = [aws_sagemaker_endpoint_configuration.test]
+}
+`)
+}
+
+func testAccAWSSagemakerEndpointConfigurationsDataSourceConfigCreatedBefore(rName, createdBefore string) string {
+	return composeConfig(
+		testAccSagemakerEndpointConfigurationConfig_Basic(rName),
+		fmt.Sprintf(`
+data "aws_sagemaker_endpoint_configurations" "test" {
+  created_before = %[1]q
+
+  depends_on = [aws_sagemaker_endpoint_configuration.test]
+}
+`, createdBefore))
+}
+
+func testAccAWSSagemakerEndpointConfigurationsDataSourceConfigCreatedBeforeOnly(createdBefore string) string {
+	return fmt.Sprintf(`
+data "aws_sagemaker_endpoint_configurations" "test" {
+  created_before = %[1]q
+}
+`, createdBefore)
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func dataSourceAwsSagemakerModel() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsSagemakerModelRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceAwsSagemakerModelContainerSchema(),
+			},
+			"enable_network_isolation": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"execution_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inference_execution_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+			"primary_container": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dataSourceAwsSagemakerModelContainerSchema(),
+			},
+			"tags": tagsSchemaComputed(),
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnets": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsSagemakerModelContainerSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"container_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"image": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repository_access_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"model_data_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsSagemakerModelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+	ignoreTagsConfig := meta.(*AWSClient).IgnoreTagsConfig
+
+	name := d.Get("name").(string)
+	model, err := finder.ModelByName(conn, name)
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("no SageMaker Model matched name (%s); change the search criteria and try again", name)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Model (%s): %w", name, err)
+	}
+
+	arn := aws.StringValue(model.ModelArn)
+	d.SetId(aws.StringValue(model.ModelName))
+	d.Set("arn", arn)
+	d.Set("enable_network_isolation", model.EnableNetworkIsolation)
+	d.Set("execution_role_arn", model.ExecutionRoleArn)
+	d.Set("name", model.ModelName)
+
+	if err := d.Set("container", flattenContainers(model.Containers)); err != nil {
+		return fmt.Errorf("error setting container: %w", err)
+	}
+
+	if err := d.Set("inference_execution_config", flattenSagemakerModelInferenceExecutionConfig(model.InferenceExecutionConfig)); err != nil {
+		return fmt.Errorf("error setting inference_execution_config: %w", err)
+	}
+
+	if err := d.Set("primary_container", flattenContainer(model.PrimaryContainer)); err != nil {
+		return fmt.Errorf("error setting primary_container: %w", err)
+	}
+
+	if err := d.Set("vpc_config", flattenSageMakerVpcConfigResponse(model.VpcConfig)); err != nil {
+		return fmt.Errorf("error setting vpc_config: %w", err)
+	}
+
+	tags, err := keyvaluetags.SagemakerListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker Model (%s): %w", arn, err)
+	}
+
+	if err := d.Set("tags", tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
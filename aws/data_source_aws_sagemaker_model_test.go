@@ -0,0 +1,129 @@
+package aws
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSSagemakerModelDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_sagemaker_model.test"
+	resourceName := "aws_sagemaker_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "container.#", resourceName, "container.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "enable_network_isolation", resourceName, "enable_network_isolation"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "execution_role_arn", resourceName, "execution_role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "primary_container.#", resourceName, "primary_container.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "primary_container.0.image", resourceName, "primary_container.0.image"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "primary_container.0.environment.%", resourceName, "primary_container.0.environment.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "primary_container.0.environment.foo", resourceName, "primary_container.0.environment.foo"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_config.#", resourceName, "vpc_config.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModelDataSource_networkIsolation(t *testing.T) {
+	dataSourceName := "data.aws_sagemaker_model.test"
+	resourceName := "aws_sagemaker_model.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerModelDataSourceConfigNetworkIsolation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "enable_network_isolation", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "enable_network_isolation", resourceName, "enable_network_isolation"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerModelDataSource_NonExistent(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { testAccPreCheck(t) },
+		ErrorCheck: testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:  testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSagemakerModelDataSourceConfigNonExistent(rName),
+				ExpectError: regexp.MustCompile(`no SageMaker Model matched`),
+			},
+		},
+	})
+}
+
+func testAccAWSSagemakerModelDataSourceConfig(rName string) string {
+	return composeConfig(testAccSagemakerModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+
+  primary_container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+
+    environment = {
+      foo = "bar"
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_sagemaker_model" "test" {
+  name = aws_sagemaker_model.test.name
+}
+`, rName))
+}
+
+func testAccAWSSagemakerModelDataSourceConfigNetworkIsolation(rName string) string {
+	return composeConfig(testAccSagemakerModelConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_model" "test" {
+  name                     = %[1]q
+  execution_role_arn       = aws_iam_role.test.arn
+  enable_network_isolation = true
+
+  primary_container {
+    image = data.aws_sagemaker_prebuilt_ecr_image.test.registry_path
+  }
+}
+
+data "aws_sagemaker_model" "test" {
+  name = aws_sagemaker_model.test.name
+}
+`, rName))
+}
+
+func testAccAWSSagemakerModelDataSourceConfigNonExistent(rName string) string {
+	return fmt.Sprintf(`
+data "aws_sagemaker_model" "test" {
+  name = %[1]q
+}
+`, rName)
+}
@@ -64,3 +64,16 @@ const (
 	// A session name for the assumed role
 	TfAwsAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
+
+// Custom environment variables used to configure resource sweepers
+const (
+	// The format of sweeper result log lines. Set to "json" to emit one
+	// structured JSON line per swept resource.
+	// Defaults to plain logging.
+	TfAwsSweepLogFormat = "TF_AWS_SWEEP_LOG_FORMAT"
+
+	// The minimum age, as a Go duration string (e.g. "2h"), of resources
+	// deleted by sweepers that support it. Younger resources are skipped.
+	// Defaults to sweeping resources of any age.
+	TfAwsSweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+)
@@ -76,6 +76,7 @@ var serviceNames = []string{
 	"glue",
 	"guardduty",
 	"greengrass",
+	"healthlake",
 	"imagebuilder",
 	"inspector",
 	"iot",
@@ -149,9 +150,11 @@ func main() {
 		"ListTagsInputIdentifierField":         keyvaluetags.ServiceListTagsInputIdentifierField,
 		"ListTagsInputIdentifierRequiresSlice": keyvaluetags.ServiceListTagsInputIdentifierRequiresSlice,
 		"ListTagsOutputTagsField":              keyvaluetags.ServiceListTagsOutputTagsField,
+		"ListTagsPaginated":                    keyvaluetags.ServiceListTagsPaginated,
 		"ParentResourceNotFoundError":          keyvaluetags.ServiceParentResourceNotFoundError,
 		"TagPackage":                           keyvaluetags.ServiceTagPackage,
 		"TagResourceTypeField":                 keyvaluetags.ServiceTagResourceTypeField,
+		"TagType":                              keyvaluetags.ServiceTagType,
 		"TagTypeIdentifierField":               keyvaluetags.ServiceTagTypeIdentifierField,
 		"Title":                                strings.Title,
 	}
@@ -196,7 +199,11 @@ var templateBody = `
 package keyvaluetags
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 {{- range .ServiceNames }}
 	"github.com/aws/aws-sdk-go/service/{{ . }}"
 {{- end }}
@@ -208,7 +215,15 @@ import (
 // {{ . | Title }}ListTags lists {{ . }} service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func {{ . | Title }}ListTags(conn {{ . | ClientType }}, identifier string{{ if . | TagResourceTypeField }}, resourceType string{{ end }}) (KeyValueTags, error) {
+{{- if . | TagResourceTypeField }}
+// The resourceType ({{ . | TagResourceTypeField }}) is required and must not be empty.
+{{- end }}
+func {{ . | Title }}ListTags(conn {{ . | ClientType }}, identifier string{{ if . | TagResourceTypeField }}, resourceType string{{ end }}, opts ...request.Option) (KeyValueTags, error) {
+	{{- if . | TagResourceTypeField }}
+	if resourceType == "" {
+		return New(nil), fmt.Errorf("{{ . | TagResourceTypeField }} is required to list {{ . }} tags for (%s)", identifier)
+	}
+	{{ end }}
 	input := &{{ . | TagPackage  }}.{{ . | ListTagsFunction }}Input{
 		{{- if . | ListTagsInputFilterIdentifierName }}
 		Filters: []*{{ . | TagPackage  }}.Filter{
@@ -229,7 +244,21 @@ func {{ . | Title }}ListTags(conn {{ . | ClientType }}, identifier string{{ if .
 		{{- end }}
 	}
 
-	output, err := conn.{{ . | ListTagsFunction }}(input)
+	{{ if . | ListTagsPaginated -}}
+	var tags []*{{ . | TagPackage }}.{{ . | TagType }}
+
+	err := conn.{{ . | ListTagsFunction }}PagesWithContext(context.Background(), input, func(page *{{ . | TagPackage }}.{{ . | ListTagsFunction }}Output, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		tags = append(tags, page.{{ . | ListTagsOutputTagsField }}...)
+
+		return !lastPage
+	}, opts...)
+	{{- else -}}
+	output, err := conn.{{ . | ListTagsFunction }}WithContext(context.Background(), input, opts...)
+	{{- end }}
 
 	{{ . | ParentResourceNotFoundError }}
 
@@ -237,7 +266,11 @@ func {{ . | Title }}ListTags(conn {{ . | ClientType }}, identifier string{{ if .
 		return New(nil), err
 	}
 
+	{{ if . | ListTagsPaginated -}}
+	return {{ . | Title }}KeyValueTags(tags{{ if . | TagTypeIdentifierField }}, identifier{{ if . | TagResourceTypeField }}, resourceType{{ end }}{{ end }}), nil
+	{{- else -}}
 	return {{ . | Title }}KeyValueTags(output.{{ . | ListTagsOutputTagsField }}{{ if . | TagTypeIdentifierField }}, identifier{{ if . | TagResourceTypeField }}, resourceType{{ end }}{{ end }}), nil
+	{{- end }}
 }
 {{- end }}
 `
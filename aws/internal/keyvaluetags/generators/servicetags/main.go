@@ -62,6 +62,7 @@ var sliceServiceNames = []string{
 	"fsx",
 	"gamelift",
 	"globalaccelerator",
+	"healthlake",
 	"iam",
 	"inspector",
 	"iot",
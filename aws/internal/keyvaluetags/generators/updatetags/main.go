@@ -80,6 +80,7 @@ var serviceNames = []string{
 	"glue",
 	"guardduty",
 	"greengrass",
+	"healthlake",
 	"imagebuilder",
 	"iot",
 	"iotanalytics",
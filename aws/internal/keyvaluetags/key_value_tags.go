@@ -13,16 +13,21 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/hashcode"
 )
 
 const (
 	AwsTagKeyPrefix                             = `aws:`
+	CognitoTagKeyPrefix                         = `cognito:`
 	ElasticbeanstalkTagKeyPrefix                = `elasticbeanstalk:`
 	NameTagKey                                  = `Name`
+	RedactedTagValue                            = `(redacted)`
 	RdsTagKeyPrefix                             = `rds:`
 	ServerlessApplicationRepositoryTagKeyPrefix = `serverlessrepo:`
+	TagKeyMaxLength                             = 128
+	TagValueMaxLength                           = 256
 )
 
 // DefaultConfig contains tags to default across all resources.
@@ -94,6 +99,25 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// IgnoreCognito returns non-AWS and non-Cognito tag keys.
+func (tags KeyValueTags) IgnoreCognito() KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if strings.HasPrefix(k, AwsTagKeyPrefix) {
+			continue
+		}
+
+		if strings.HasPrefix(k, CognitoTagKeyPrefix) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
@@ -206,6 +230,13 @@ func (tags KeyValueTags) Ignore(ignoreTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// IsNil returns whether or not the KeyValueTags are nil.
+// KeyValueTags created with NewOrNil from nil input are nil,
+// which distinguishes unset tags from empty tags.
+func (tags KeyValueTags) IsNil() bool {
+	return tags == nil
+}
+
 // KeyAdditionalBoolValue returns the boolean value of an additional tag field.
 // If the key or additional field is not found, returns nil.
 func (tags KeyValueTags) KeyAdditionalBoolValue(key string, fieldName string) *bool {
@@ -371,6 +402,45 @@ func (tags KeyValueTags) Only(onlyTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// Redacted returns a copy of the tags with all values, including additional
+// string field values, replaced by RedactedTagValue. Intended for logging.
+func (tags KeyValueTags) Redacted() KeyValueTags {
+	result := make(KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		if v == nil {
+			result[k] = nil
+			continue
+		}
+
+		td := &TagData{
+			AdditionalBoolFields: v.AdditionalBoolFields,
+		}
+
+		if v.AdditionalStringFields != nil {
+			td.AdditionalStringFields = make(map[string]*string, len(v.AdditionalStringFields))
+
+			for field, value := range v.AdditionalStringFields {
+				if value != nil {
+					str := RedactedTagValue
+					value = &str
+				}
+
+				td.AdditionalStringFields[field] = value
+			}
+		}
+
+		if v.Value != nil {
+			str := RedactedTagValue
+			td.Value = &str
+		}
+
+		result[k] = td
+	}
+
+	return result
+}
+
 // Removed returns tags removed.
 func (tags KeyValueTags) Removed(newTags KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -397,6 +467,29 @@ func (tags KeyValueTags) Updated(newTags KeyValueTags) KeyValueTags {
 	return result
 }
 
+// Diff returns tags added, removed and changed in newTags.
+func (tags KeyValueTags) Diff(newTags KeyValueTags) (added, removed, changed KeyValueTags) {
+	added = make(KeyValueTags)
+	removed = make(KeyValueTags)
+	changed = make(KeyValueTags)
+
+	for k, newV := range newTags {
+		if oldV, ok := tags[k]; !ok {
+			added[k] = newV
+		} else if !oldV.Equal(newV) {
+			changed[k] = newV
+		}
+	}
+
+	for k, v := range tags {
+		if _, ok := newTags[k]; !ok {
+			removed[k] = v
+		}
+	}
+
+	return added, removed, changed
+}
+
 // Chunks returns a slice of KeyValueTags, each of the specified size.
 func (tags KeyValueTags) Chunks(size int) []KeyValueTags {
 	result := []KeyValueTags{}
@@ -494,6 +587,21 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	return result
 }
 
+// RemoveKeys returns a copy of the KeyValueTags without the given keys.
+func (tags KeyValueTags) RemoveKeys(keys ...string) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	for _, k := range keys {
+		delete(result, k)
+	}
+
+	return result
+}
+
 // String returns the default string representation of the KeyValueTags.
 func (tags KeyValueTags) String() string {
 	var builder strings.Builder
@@ -513,6 +621,16 @@ func (tags KeyValueTags) String() string {
 	return builder.String()
 }
 
+// StringRedacted returns the string representation of the KeyValueTags,
+// with values redacted if sensitive is true.
+func (tags KeyValueTags) StringRedacted(sensitive bool) string {
+	if sensitive {
+		return tags.Redacted().String()
+	}
+
+	return tags.String()
+}
+
 // UrlEncode returns the KeyValueTags encoded as URL Query parameters.
 func (tags KeyValueTags) UrlEncode() string {
 	values := url.Values{}
@@ -552,6 +670,50 @@ func (tags KeyValueTags) UrlQueryString() string {
 	return buf.String()
 }
 
+// URLEncodedValues returns the KeyValueTags as a map with URL query encoded values,
+// for services that pass tags in query strings. Keys are not encoded.
+func (tags KeyValueTags) URLEncodedValues() map[string]string {
+	result := make(map[string]string, len(tags))
+
+	for k, v := range tags {
+		if v == nil || v.Value == nil {
+			result[k] = ""
+			continue
+		}
+
+		result[k] = url.QueryEscape(*v.Value)
+	}
+
+	return result
+}
+
+// Validate returns an error listing any tag keys or values that exceed
+// the AWS tag length limits, measured in Unicode characters.
+func (tags KeyValueTags) Validate() error {
+	keys := tags.Keys()
+	sort.Strings(keys)
+
+	var problems []string
+
+	for _, k := range keys {
+		if n := utf8.RuneCountInString(k); n > TagKeyMaxLength {
+			problems = append(problems, fmt.Sprintf("tag key (%s) is %d characters, maximum is %d", k, n, TagKeyMaxLength))
+		}
+
+		if v := tags.KeyValue(k); v != nil {
+			if n := utf8.RuneCountInString(*v); n > TagValueMaxLength {
+				problems = append(problems, fmt.Sprintf("tag value for key (%s) is %d characters, maximum is %d", k, n, TagValueMaxLength))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid tags: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
 // New creates KeyValueTags from common types or returns an empty KeyValueTags.
 //
 // Supports various Terraform Plugin SDK types including map[string]string,
@@ -623,6 +785,24 @@ func New(i interface{}) KeyValueTags {
 	}
 }
 
+// NewOrNil creates KeyValueTags like New, except that nil input
+// (including typed nil maps and slices) returns nil KeyValueTags
+// instead of empty KeyValueTags.
+func NewOrNil(i interface{}) KeyValueTags {
+	if i == nil {
+		return nil
+	}
+
+	switch v := reflect.ValueOf(i); v.Kind() {
+	case reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return nil
+		}
+	}
+
+	return New(i)
+}
+
 // TagData represents the data associated with a resource tag key.
 // Almost exclusively for AWS services, this is just a tag value,
 // however there are services that attach additional data to tags.
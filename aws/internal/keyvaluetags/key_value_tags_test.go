@@ -1,6 +1,7 @@
 package keyvaluetags
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -173,6 +174,35 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "keys all overridden",
+			tags: New(map[string]string{
+				"key1": "value4",
+				"key2": "value5",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value4",
+				"key2": "value5",
+			},
+		},
+		{
+			name: "nil tags",
+			tags: nil,
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -347,6 +377,62 @@ func TestKeyValueTagsIgnoreAws(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreCognito(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"cognito:key2":            "value2",
+			}),
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"aws:cloudformation:key1": "value1",
+				"key2":                    "value2",
+				"cognito:key3":            "value3",
+				"key4":                    "value4",
+			}),
+			want: map[string]string{
+				"key2": "value2",
+				"key4": "value4",
+			},
+		},
+		{
+			name: "none",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreCognito()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -820,6 +906,50 @@ func TestKeyValueTagsIgnore(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIsNil(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want bool
+	}{
+		{
+			name: "nil",
+			tags: nil,
+			want: true,
+		},
+		{
+			name: "New nil",
+			tags: New(nil),
+			want: false,
+		},
+		{
+			name: "NewOrNil nil",
+			tags: NewOrNil(nil),
+			want: true,
+		},
+		{
+			name: "NewOrNil empty",
+			tags: NewOrNil(map[string]string{}),
+			want: false,
+		},
+		{
+			name: "NewOrNil non-empty",
+			tags: NewOrNil(map[string]string{"key1": "value1"}),
+			want: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IsNil()
+
+			if got != testCase.want {
+				t.Fatalf("expected: %t, got: %t", testCase.want, got)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsKeyAdditionalBoolValue(t *testing.T) {
 	testCases := []struct {
 		name  string
@@ -1504,6 +1634,84 @@ func TestKeyValueTagsOnly(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRedacted(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "no value",
+			tags: New(map[string]*string{
+				"key1": nil,
+			}),
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+		{
+			name: "multiple",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "secret",
+			}),
+			want: map[string]string{
+				"key1": RedactedTagValue,
+				"key2": RedactedTagValue,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.Redacted()
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+
+			if len(got) != len(testCase.tags) {
+				t.Errorf("got %d keys, want %d", len(got), len(testCase.tags))
+			}
+		})
+	}
+}
+
+func TestKeyValueTagsRedactedAdditionalFields(t *testing.T) {
+	tags := New(map[string]*TagData{
+		"key1": {
+			AdditionalBoolFields: map[string]*bool{
+				"PropagateAtLaunch": testBoolPtr(true),
+			},
+			AdditionalStringFields: map[string]*string{
+				"ResourceId": testStringPtr("secret"),
+			},
+			Value: testStringPtr("value1"),
+		},
+	})
+
+	got := tags.Redacted()
+
+	if v := got.KeyValue("key1"); v == nil || *v != RedactedTagValue {
+		t.Errorf("unexpected value: %v", v)
+	}
+
+	if v := got.KeyAdditionalStringValue("key1", "ResourceId"); v == nil || *v != RedactedTagValue {
+		t.Errorf("unexpected additional string value: %v", v)
+	}
+
+	if v := got.KeyAdditionalBoolValue("key1", "PropagateAtLaunch"); v == nil || !*v {
+		t.Errorf("unexpected additional bool value: %v", v)
+	}
+
+	if v := tags.KeyValue("key1"); v == nil || *v != "value1" {
+		t.Errorf("original tags modified: %v", v)
+	}
+}
+
 func TestKeyValueTagsRemoved(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -1647,6 +1855,88 @@ func TestKeyValueTagsUpdated(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDiff(t *testing.T) {
+	testCases := []struct {
+		name        string
+		oldTags     KeyValueTags
+		newTags     KeyValueTags
+		wantAdded   map[string]string
+		wantRemoved map[string]string
+		wantChanged map[string]string
+	}{
+		{
+			name:        "empty",
+			oldTags:     New(map[string]string{}),
+			newTags:     New(map[string]string{}),
+			wantAdded:   map[string]string{},
+			wantRemoved: map[string]string{},
+			wantChanged: map[string]string{},
+		},
+		{
+			name:    "added",
+			oldTags: New(map[string]string{"key1": "value1"}),
+			newTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			wantAdded:   map[string]string{"key2": "value2"},
+			wantRemoved: map[string]string{},
+			wantChanged: map[string]string{},
+		},
+		{
+			name: "removed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags:     New(map[string]string{"key1": "value1"}),
+			wantAdded:   map[string]string{},
+			wantRemoved: map[string]string{"key2": "value2"},
+			wantChanged: map[string]string{},
+		},
+		{
+			name: "changed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2updated",
+			}),
+			wantAdded:   map[string]string{},
+			wantRemoved: map[string]string{},
+			wantChanged: map[string]string{"key2": "value2updated"},
+		},
+		{
+			name: "mixed",
+			oldTags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			newTags: New(map[string]string{
+				"key1": "value1updated",
+				"key3": "value3",
+				"key4": "value4",
+			}),
+			wantAdded:   map[string]string{"key4": "value4"},
+			wantRemoved: map[string]string{"key2": "value2"},
+			wantChanged: map[string]string{"key1": "value1updated"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			added, removed, changed := testCase.oldTags.Diff(testCase.newTags)
+
+			testKeyValueTagsVerifyMap(t, added.Map(), testCase.wantAdded)
+			testKeyValueTagsVerifyMap(t, removed.Map(), testCase.wantRemoved)
+			testKeyValueTagsVerifyMap(t, changed.Map(), testCase.wantChanged)
+		})
+	}
+}
+
 func TestKeyValueTagsChunks(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -1991,6 +2281,27 @@ func TestKeyValueTagsHash(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsHashStable(t *testing.T) {
+	tags1 := make(KeyValueTags)
+	tags2 := make(KeyValueTags)
+
+	keys := []string{"key1", "key2", "key3", "key4", "key5"}
+
+	for _, k := range keys {
+		v := "value-" + k
+		tags1[k] = &TagData{Value: &v}
+	}
+
+	for i := len(keys) - 1; i >= 0; i-- {
+		v := "value-" + keys[i]
+		tags2[keys[i]] = &TagData{Value: &v}
+	}
+
+	if got, want := tags1.Hash(), tags2.Hash(); got != want {
+		t.Errorf("expected same hash code regardless of insertion order, got %d and %d", got, want)
+	}
+}
+
 func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -2120,6 +2431,65 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsRemoveKeys(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		keys []string
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			keys: []string{"key1"},
+			want: map[string]string{},
+		},
+		{
+			name: "no keys",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "all",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			keys: []string{"key1", "key2"},
+			want: map[string]string{},
+		},
+		{
+			name: "mixed",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			keys: []string{"key1", "key3", "key4"},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			original := testCase.tags.Map()
+
+			got := testCase.tags.RemoveKeys(testCase.keys...)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+			testKeyValueTagsVerifyMap(t, testCase.tags.Map(), original)
+		})
+	}
+}
+
 func TestKeyValueTagsUrlEncode(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -2232,6 +2602,142 @@ func TestKeyValueTagsUrlQueryString(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsURLEncodedValues(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: map[string]string{},
+		},
+		{
+			name: "nil value",
+			tags: New(map[string]*string{
+				"key1": nil,
+			}),
+			want: map[string]string{
+				"key1": "",
+			},
+		},
+		{
+			name: "unencoded",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "encoded",
+			tags: New(map[string]string{
+				"key1":  "value 1",
+				"key@2": "value+:2",
+				"key3":  "a&b=c/d?e#f%",
+			}),
+			want: map[string]string{
+				"key1":  "value+1",
+				"key@2": "value%2B%3A2",
+				"key3":  "a%26b%3Dc%2Fd%3Fe%23f%25",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.URLEncodedValues()
+
+			testKeyValueTagsVerifyMap(t, got, testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		tags    KeyValueTags
+		wantErr []string
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+		},
+		{
+			name: "at limits",
+			tags: New(map[string]string{
+				strings.Repeat("k", TagKeyMaxLength): strings.Repeat("v", TagValueMaxLength),
+			}),
+		},
+		{
+			name: "multibyte at limits",
+			tags: New(map[string]string{
+				strings.Repeat("é", TagKeyMaxLength): strings.Repeat("é", TagValueMaxLength),
+			}),
+		},
+		{
+			name: "nil value",
+			tags: New(map[string]*string{
+				"key1": nil,
+			}),
+		},
+		{
+			name: "key over limit",
+			tags: New(map[string]string{
+				strings.Repeat("k", TagKeyMaxLength+1): "value1",
+			}),
+			wantErr: []string{"is 129 characters, maximum is 128"},
+		},
+		{
+			name: "value over limit",
+			tags: New(map[string]string{
+				"key1": strings.Repeat("v", TagValueMaxLength+1),
+			}),
+			wantErr: []string{"tag value for key (key1) is 257 characters, maximum is 256"},
+		},
+		{
+			name: "key and value over limit",
+			tags: New(map[string]string{
+				"key1":                                 strings.Repeat("v", TagValueMaxLength+1),
+				strings.Repeat("k", TagKeyMaxLength+1): "value2",
+				"key3":                                 "value3",
+			}),
+			wantErr: []string{
+				"tag value for key (key1) is 257 characters, maximum is 256",
+				"is 129 characters, maximum is 128",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.tags.Validate()
+
+			if len(testCase.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			for _, want := range testCase.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("expected error to contain %q, got: %s", want, err)
+				}
+			}
+		})
+	}
+}
+
 func TestNew(t *testing.T) {
 	testCases := []struct {
 		name   string
@@ -2386,6 +2892,78 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNewOrNil(t *testing.T) {
+	testCases := []struct {
+		name    string
+		source  interface{}
+		wantNil bool
+		want    map[string]string
+	}{
+		{
+			name:    "nil",
+			source:  nil,
+			wantNil: true,
+		},
+		{
+			name:    "nil_KeyValueTags",
+			source:  KeyValueTags(nil),
+			wantNil: true,
+		},
+		{
+			name:    "nil_map_string_interface",
+			source:  map[string]interface{}(nil),
+			wantNil: true,
+		},
+		{
+			name:    "nil_map_string_string",
+			source:  map[string]string(nil),
+			wantNil: true,
+		},
+		{
+			name:    "nil_slice_interface",
+			source:  []interface{}(nil),
+			wantNil: true,
+		},
+		{
+			name:   "empty_map_string_interface",
+			source: map[string]interface{}{},
+			want:   map[string]string{},
+		},
+		{
+			name:   "empty_map_string_string",
+			source: map[string]string{},
+			want:   map[string]string{},
+		},
+		{
+			name: "non_empty_map_string_string",
+			source: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := NewOrNil(testCase.source)
+
+			if got.IsNil() != testCase.wantNil {
+				t.Fatalf("expected nil: %t, got: %#v", testCase.wantNil, got)
+			}
+
+			if testCase.wantNil {
+				return
+			}
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestTagDataEqual(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -2660,6 +3238,252 @@ func TestKeyValueTagsString(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsStringRedacted(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tags      KeyValueTags
+		sensitive bool
+		want      string
+	}{
+		{
+			name: "not sensitive",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			want: "map[key1:TagData{Value: value1}]",
+		},
+		{
+			name: "sensitive",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			sensitive: true,
+			want:      "map[key1:TagData{Value: (redacted)}]",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.StringRedacted(testCase.sensitive)
+
+			if got != testCase.want {
+				t.Errorf("unexpected string value: %q", got)
+			}
+		})
+	}
+}
+
+// TestKeyValueTagsReadPipeline exercises the chain of methods used by resource Read
+// functions to compute tags and tags_all from the tags returned by AWS, e.g.
+//
+//	tags = tags.IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+//	d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map())
+//	d.Set("tags_all", tags.Map())
+func TestKeyValueTagsReadPipeline(t *testing.T) {
+	// Representative tags as returned by AWS for a resource created with provider default tags.
+	apiTags := map[string]string{
+		"aws:cloudformation:stack-name": "stack",
+		"cognito:user-pool":             "pool",
+		"Environment":                   "production",
+		"Name":                          "example",
+		"Owner":                         "team-a",
+		"CostCenter":                    "1234",
+		"kubernetes.io/cluster/test":    "owned",
+		"Ignored":                       "value",
+	}
+
+	testCases := []struct {
+		name         string
+		ignoreSystem func(KeyValueTags) KeyValueTags
+		defaultTags  *DefaultConfig
+		ignoreConfig *IgnoreConfig
+		wantTags     map[string]string
+		wantTagsAll  map[string]string
+	}{
+		{
+			name:         "sagemaker no configuration",
+			ignoreSystem: KeyValueTags.IgnoreAws,
+			wantTags: map[string]string{
+				"cognito:user-pool":          "pool",
+				"Environment":                "production",
+				"Name":                       "example",
+				"Owner":                      "team-a",
+				"CostCenter":                 "1234",
+				"kubernetes.io/cluster/test": "owned",
+				"Ignored":                    "value",
+			},
+			wantTagsAll: map[string]string{
+				"cognito:user-pool":          "pool",
+				"Environment":                "production",
+				"Name":                       "example",
+				"Owner":                      "team-a",
+				"CostCenter":                 "1234",
+				"kubernetes.io/cluster/test": "owned",
+				"Ignored":                    "value",
+			},
+		},
+		{
+			name:         "cognito default and ignore configuration",
+			ignoreSystem: KeyValueTags.IgnoreCognito,
+			defaultTags: &DefaultConfig{
+				Tags: New(map[string]string{
+					"Environment": "production",
+					"Owner":       "team-b",
+				}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"Ignored"}),
+				KeyPrefixes: New([]string{"kubernetes.io/"}),
+			},
+			wantTags: map[string]string{
+				"Name":       "example",
+				"Owner":      "team-a",
+				"CostCenter": "1234",
+			},
+			wantTagsAll: map[string]string{
+				"Environment": "production",
+				"Name":        "example",
+				"Owner":       "team-a",
+				"CostCenter":  "1234",
+			},
+		},
+		{
+			name:         "sagemaker default and ignore configuration",
+			ignoreSystem: KeyValueTags.IgnoreAws,
+			defaultTags: &DefaultConfig{
+				Tags: New(map[string]string{
+					"Environment": "production",
+					"Owner":       "team-b",
+				}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"Ignored"}),
+				KeyPrefixes: New([]string{"kubernetes.io/"}),
+			},
+			wantTags: map[string]string{
+				"cognito:user-pool": "pool",
+				"Name":              "example",
+				"Owner":             "team-a",
+				"CostCenter":        "1234",
+			},
+			wantTagsAll: map[string]string{
+				"cognito:user-pool": "pool",
+				"Environment":       "production",
+				"Name":              "example",
+				"Owner":             "team-a",
+				"CostCenter":        "1234",
+			},
+		},
+		{
+			name:         "ignore configuration overlaps default tags",
+			ignoreSystem: KeyValueTags.IgnoreAws,
+			defaultTags: &DefaultConfig{
+				Tags: New(map[string]string{
+					"Environment": "production",
+					"Ignored":     "value",
+				}),
+			},
+			ignoreConfig: &IgnoreConfig{
+				Keys:        New([]string{"Environment", "Ignored", "cognito:user-pool"}),
+				KeyPrefixes: New([]string{"kubernetes.io/", "aws:"}),
+			},
+			wantTags: map[string]string{
+				"Name":       "example",
+				"Owner":      "team-a",
+				"CostCenter": "1234",
+			},
+			wantTagsAll: map[string]string{
+				"Name":       "example",
+				"Owner":      "team-a",
+				"CostCenter": "1234",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tags := New(apiTags)
+			before := tags.Map()
+
+			read := func(tags KeyValueTags) (map[string]string, map[string]string) {
+				tags = testCase.ignoreSystem(tags).IgnoreConfig(testCase.ignoreConfig)
+
+				return tags.RemoveDefaultConfig(testCase.defaultTags).Map(), tags.Map()
+			}
+
+			gotTags, gotTagsAll := read(tags)
+
+			testKeyValueTagsVerifyMap(t, gotTags, testCase.wantTags)
+			testKeyValueTagsVerifyMap(t, gotTagsAll, testCase.wantTagsAll)
+
+			// The pipeline must not modify the tags returned by AWS.
+			testKeyValueTagsVerifyMap(t, tags.Map(), before)
+
+			// Reading tags_all again must not change the result.
+			againTags, againTagsAll := read(New(gotTagsAll))
+
+			testKeyValueTagsVerifyMap(t, againTags, testCase.wantTags)
+			testKeyValueTagsVerifyMap(t, againTagsAll, testCase.wantTagsAll)
+
+			// Ignoring configured tags before system tags must not change the result.
+			reordered := testCase.ignoreSystem(tags.IgnoreConfig(testCase.ignoreConfig))
+
+			testKeyValueTagsVerifyMap(t, reordered.RemoveDefaultConfig(testCase.defaultTags).Map(), testCase.wantTags)
+			testKeyValueTagsVerifyMap(t, reordered.Map(), testCase.wantTagsAll)
+
+			// Removing default tags before ignoring tags must not change tags.
+			testKeyValueTagsVerifyMap(t, testCase.ignoreSystem(tags.RemoveDefaultConfig(testCase.defaultTags)).IgnoreConfig(testCase.ignoreConfig).Map(), testCase.wantTags)
+		})
+	}
+}
+
+// TestKeyValueTagsWriteReadPipeline verifies that tags written with provider default
+// tags merged in, as done by resource Create and Update functions, are read back unchanged.
+func TestKeyValueTagsWriteReadPipeline(t *testing.T) {
+	defaultTags := &DefaultConfig{
+		Tags: New(map[string]string{
+			"Environment": "production",
+			"Owner":       "team-b",
+		}),
+	}
+	ignoreConfig := &IgnoreConfig{
+		KeyPrefixes: New([]string{"kubernetes.io/"}),
+	}
+	configTags := New(map[string]interface{}{
+		"Name":                       "example",
+		"Owner":                      "team-a",
+		"kubernetes.io/cluster/test": "owned",
+	})
+
+	// Create/Update: merge default tags, then drop any system tags.
+	written := defaultTags.MergeTags(configTags).IgnoreAws()
+
+	// AWS adds a system tag.
+	apiTags := written.Merge(New(map[string]string{"aws:cloudformation:stack-name": "stack"}))
+
+	// Read.
+	tags := apiTags.IgnoreAws().IgnoreConfig(ignoreConfig)
+
+	testKeyValueTagsVerifyMap(t, tags.RemoveDefaultConfig(defaultTags).Map(), map[string]string{
+		"Name":  "example",
+		"Owner": "team-a",
+	})
+	testKeyValueTagsVerifyMap(t, tags.Map(), map[string]string{
+		"Environment": "production",
+		"Name":        "example",
+		"Owner":       "team-a",
+	})
+
+	// Nothing is updated when the read tags_all is compared with the written tags.
+	if updated := written.IgnoreConfig(ignoreConfig).Updated(tags); len(updated) != 0 {
+		t.Errorf("unexpected updated tags: %v", updated.Map())
+	}
+
+	if removed := written.IgnoreConfig(ignoreConfig).Removed(tags); len(removed) != 0 {
+		t.Errorf("unexpected removed tags: %v", removed.Map())
+	}
+}
+
 func testKeyValueTagsVerifyKeys(t *testing.T, got []string, want []string) {
 	for _, g := range got {
 		found := false
@@ -3,7 +3,11 @@
 package keyvaluetags
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/aws/aws-sdk-go/service/acmpca"
@@ -62,6 +66,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/greengrass"
 	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/healthlake"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -122,12 +127,12 @@ import (
 // AccessanalyzerListTags lists accessanalyzer service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AccessanalyzerListTags(conn *accessanalyzer.AccessAnalyzer, identifier string) (KeyValueTags, error) {
+func AccessanalyzerListTags(conn *accessanalyzer.AccessAnalyzer, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &accessanalyzer.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -146,12 +151,12 @@ func AccessanalyzerListTags(conn *accessanalyzer.AccessAnalyzer, identifier stri
 // AcmListTags lists acm service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AcmListTags(conn *acm.ACM, identifier string) (KeyValueTags, error) {
+func AcmListTags(conn *acm.ACM, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &acm.ListTagsForCertificateInput{
 		CertificateArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForCertificate(input)
+	output, err := conn.ListTagsForCertificateWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -170,12 +175,12 @@ func AcmListTags(conn *acm.ACM, identifier string) (KeyValueTags, error) {
 // AcmpcaListTags lists acmpca service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AcmpcaListTags(conn *acmpca.ACMPCA, identifier string) (KeyValueTags, error) {
+func AcmpcaListTags(conn *acmpca.ACMPCA, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &acmpca.ListTagsInput{
 		CertificateAuthorityArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -194,12 +199,12 @@ func AcmpcaListTags(conn *acmpca.ACMPCA, identifier string) (KeyValueTags, error
 // AmplifyListTags lists amplify service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AmplifyListTags(conn *amplify.Amplify, identifier string) (KeyValueTags, error) {
+func AmplifyListTags(conn *amplify.Amplify, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &amplify.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -218,12 +223,12 @@ func AmplifyListTags(conn *amplify.Amplify, identifier string) (KeyValueTags, er
 // Apigatewayv2ListTags lists apigatewayv2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Apigatewayv2ListTags(conn *apigatewayv2.ApiGatewayV2, identifier string) (KeyValueTags, error) {
+func Apigatewayv2ListTags(conn *apigatewayv2.ApiGatewayV2, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &apigatewayv2.GetTagsInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.GetTags(input)
+	output, err := conn.GetTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -242,12 +247,12 @@ func Apigatewayv2ListTags(conn *apigatewayv2.ApiGatewayV2, identifier string) (K
 // AppconfigListTags lists appconfig service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AppconfigListTags(conn *appconfig.AppConfig, identifier string) (KeyValueTags, error) {
+func AppconfigListTags(conn *appconfig.AppConfig, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &appconfig.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -266,12 +271,12 @@ func AppconfigListTags(conn *appconfig.AppConfig, identifier string) (KeyValueTa
 // AppmeshListTags lists appmesh service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AppmeshListTags(conn *appmesh.AppMesh, identifier string) (KeyValueTags, error) {
+func AppmeshListTags(conn *appmesh.AppMesh, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &appmesh.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -290,12 +295,12 @@ func AppmeshListTags(conn *appmesh.AppMesh, identifier string) (KeyValueTags, er
 // ApprunnerListTags lists apprunner service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ApprunnerListTags(conn *apprunner.AppRunner, identifier string) (KeyValueTags, error) {
+func ApprunnerListTags(conn *apprunner.AppRunner, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &apprunner.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -314,12 +319,12 @@ func ApprunnerListTags(conn *apprunner.AppRunner, identifier string) (KeyValueTa
 // AppstreamListTags lists appstream service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AppstreamListTags(conn *appstream.AppStream, identifier string) (KeyValueTags, error) {
+func AppstreamListTags(conn *appstream.AppStream, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &appstream.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -338,12 +343,12 @@ func AppstreamListTags(conn *appstream.AppStream, identifier string) (KeyValueTa
 // AppsyncListTags lists appsync service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AppsyncListTags(conn *appsync.AppSync, identifier string) (KeyValueTags, error) {
+func AppsyncListTags(conn *appsync.AppSync, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &appsync.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -362,12 +367,12 @@ func AppsyncListTags(conn *appsync.AppSync, identifier string) (KeyValueTags, er
 // AthenaListTags lists athena service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AthenaListTags(conn *athena.Athena, identifier string) (KeyValueTags, error) {
+func AthenaListTags(conn *athena.Athena, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &athena.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -386,7 +391,12 @@ func AthenaListTags(conn *athena.Athena, identifier string) (KeyValueTags, error
 // AutoscalingListTags lists autoscaling service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func AutoscalingListTags(conn *autoscaling.AutoScaling, identifier string, resourceType string) (KeyValueTags, error) {
+// The resourceType (ResourceType) is required and must not be empty.
+func AutoscalingListTags(conn *autoscaling.AutoScaling, identifier string, resourceType string, opts ...request.Option) (KeyValueTags, error) {
+	if resourceType == "" {
+		return New(nil), fmt.Errorf("ResourceType is required to list autoscaling tags for (%s)", identifier)
+	}
+
 	input := &autoscaling.DescribeTagsInput{
 		Filters: []*autoscaling.Filter{
 			{
@@ -396,7 +406,7 @@ func AutoscalingListTags(conn *autoscaling.AutoScaling, identifier string, resou
 		},
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -415,12 +425,12 @@ func AutoscalingListTags(conn *autoscaling.AutoScaling, identifier string, resou
 // BackupListTags lists backup service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func BackupListTags(conn *backup.Backup, identifier string) (KeyValueTags, error) {
+func BackupListTags(conn *backup.Backup, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &backup.ListTagsInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -439,12 +449,12 @@ func BackupListTags(conn *backup.Backup, identifier string) (KeyValueTags, error
 // BatchListTags lists batch service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func BatchListTags(conn *batch.Batch, identifier string) (KeyValueTags, error) {
+func BatchListTags(conn *batch.Batch, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &batch.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -463,12 +473,12 @@ func BatchListTags(conn *batch.Batch, identifier string) (KeyValueTags, error) {
 // Cloud9ListTags lists cloud9 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Cloud9ListTags(conn *cloud9.Cloud9, identifier string) (KeyValueTags, error) {
+func Cloud9ListTags(conn *cloud9.Cloud9, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cloud9.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -487,12 +497,12 @@ func Cloud9ListTags(conn *cloud9.Cloud9, identifier string) (KeyValueTags, error
 // CloudfrontListTags lists cloudfront service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CloudfrontListTags(conn *cloudfront.CloudFront, identifier string) (KeyValueTags, error) {
+func CloudfrontListTags(conn *cloudfront.CloudFront, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cloudfront.ListTagsForResourceInput{
 		Resource: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -511,12 +521,12 @@ func CloudfrontListTags(conn *cloudfront.CloudFront, identifier string) (KeyValu
 // Cloudhsmv2ListTags lists cloudhsmv2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Cloudhsmv2ListTags(conn *cloudhsmv2.CloudHSMV2, identifier string) (KeyValueTags, error) {
+func Cloudhsmv2ListTags(conn *cloudhsmv2.CloudHSMV2, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cloudhsmv2.ListTagsInput{
 		ResourceId: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -535,12 +545,12 @@ func Cloudhsmv2ListTags(conn *cloudhsmv2.CloudHSMV2, identifier string) (KeyValu
 // CloudtrailListTags lists cloudtrail service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CloudtrailListTags(conn *cloudtrail.CloudTrail, identifier string) (KeyValueTags, error) {
+func CloudtrailListTags(conn *cloudtrail.CloudTrail, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cloudtrail.ListTagsInput{
 		ResourceIdList: aws.StringSlice([]string{identifier}),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -559,12 +569,12 @@ func CloudtrailListTags(conn *cloudtrail.CloudTrail, identifier string) (KeyValu
 // CloudwatchListTags lists cloudwatch service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CloudwatchListTags(conn *cloudwatch.CloudWatch, identifier string) (KeyValueTags, error) {
+func CloudwatchListTags(conn *cloudwatch.CloudWatch, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cloudwatch.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -583,12 +593,12 @@ func CloudwatchListTags(conn *cloudwatch.CloudWatch, identifier string) (KeyValu
 // CloudwatcheventsListTags lists cloudwatchevents service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CloudwatcheventsListTags(conn *cloudwatchevents.CloudWatchEvents, identifier string) (KeyValueTags, error) {
+func CloudwatcheventsListTags(conn *cloudwatchevents.CloudWatchEvents, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cloudwatchevents.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -607,12 +617,12 @@ func CloudwatcheventsListTags(conn *cloudwatchevents.CloudWatchEvents, identifie
 // CloudwatchlogsListTags lists cloudwatchlogs service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CloudwatchlogsListTags(conn *cloudwatchlogs.CloudWatchLogs, identifier string) (KeyValueTags, error) {
+func CloudwatchlogsListTags(conn *cloudwatchlogs.CloudWatchLogs, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cloudwatchlogs.ListTagsLogGroupInput{
 		LogGroupName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsLogGroup(input)
+	output, err := conn.ListTagsLogGroupWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -631,12 +641,12 @@ func CloudwatchlogsListTags(conn *cloudwatchlogs.CloudWatchLogs, identifier stri
 // CodeartifactListTags lists codeartifact service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CodeartifactListTags(conn *codeartifact.CodeArtifact, identifier string) (KeyValueTags, error) {
+func CodeartifactListTags(conn *codeartifact.CodeArtifact, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &codeartifact.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -655,12 +665,12 @@ func CodeartifactListTags(conn *codeartifact.CodeArtifact, identifier string) (K
 // CodecommitListTags lists codecommit service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CodecommitListTags(conn *codecommit.CodeCommit, identifier string) (KeyValueTags, error) {
+func CodecommitListTags(conn *codecommit.CodeCommit, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &codecommit.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -679,12 +689,12 @@ func CodecommitListTags(conn *codecommit.CodeCommit, identifier string) (KeyValu
 // CodedeployListTags lists codedeploy service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CodedeployListTags(conn *codedeploy.CodeDeploy, identifier string) (KeyValueTags, error) {
+func CodedeployListTags(conn *codedeploy.CodeDeploy, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &codedeploy.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -703,12 +713,12 @@ func CodedeployListTags(conn *codedeploy.CodeDeploy, identifier string) (KeyValu
 // CodepipelineListTags lists codepipeline service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CodepipelineListTags(conn *codepipeline.CodePipeline, identifier string) (KeyValueTags, error) {
+func CodepipelineListTags(conn *codepipeline.CodePipeline, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &codepipeline.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -727,12 +737,12 @@ func CodepipelineListTags(conn *codepipeline.CodePipeline, identifier string) (K
 // CodestarconnectionsListTags lists codestarconnections service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CodestarconnectionsListTags(conn *codestarconnections.CodeStarConnections, identifier string) (KeyValueTags, error) {
+func CodestarconnectionsListTags(conn *codestarconnections.CodeStarConnections, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &codestarconnections.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -751,12 +761,12 @@ func CodestarconnectionsListTags(conn *codestarconnections.CodeStarConnections,
 // CodestarnotificationsListTags lists codestarnotifications service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CodestarnotificationsListTags(conn *codestarnotifications.CodeStarNotifications, identifier string) (KeyValueTags, error) {
+func CodestarnotificationsListTags(conn *codestarnotifications.CodeStarNotifications, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &codestarnotifications.ListTagsForResourceInput{
 		Arn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -775,12 +785,12 @@ func CodestarnotificationsListTags(conn *codestarnotifications.CodeStarNotificat
 // CognitoidentityListTags lists cognitoidentity service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CognitoidentityListTags(conn *cognitoidentity.CognitoIdentity, identifier string) (KeyValueTags, error) {
+func CognitoidentityListTags(conn *cognitoidentity.CognitoIdentity, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cognitoidentity.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -799,12 +809,12 @@ func CognitoidentityListTags(conn *cognitoidentity.CognitoIdentity, identifier s
 // CognitoidentityproviderListTags lists cognitoidentityprovider service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func CognitoidentityproviderListTags(conn *cognitoidentityprovider.CognitoIdentityProvider, identifier string) (KeyValueTags, error) {
+func CognitoidentityproviderListTags(conn *cognitoidentityprovider.CognitoIdentityProvider, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &cognitoidentityprovider.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -823,12 +833,12 @@ func CognitoidentityproviderListTags(conn *cognitoidentityprovider.CognitoIdenti
 // ConfigserviceListTags lists configservice service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ConfigserviceListTags(conn *configservice.ConfigService, identifier string) (KeyValueTags, error) {
+func ConfigserviceListTags(conn *configservice.ConfigService, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &configservice.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -847,12 +857,12 @@ func ConfigserviceListTags(conn *configservice.ConfigService, identifier string)
 // DatabasemigrationserviceListTags lists databasemigrationservice service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DatabasemigrationserviceListTags(conn *databasemigrationservice.DatabaseMigrationService, identifier string) (KeyValueTags, error) {
+func DatabasemigrationserviceListTags(conn *databasemigrationservice.DatabaseMigrationService, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &databasemigrationservice.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -871,12 +881,12 @@ func DatabasemigrationserviceListTags(conn *databasemigrationservice.DatabaseMig
 // DataexchangeListTags lists dataexchange service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DataexchangeListTags(conn *dataexchange.DataExchange, identifier string) (KeyValueTags, error) {
+func DataexchangeListTags(conn *dataexchange.DataExchange, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &dataexchange.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -895,12 +905,12 @@ func DataexchangeListTags(conn *dataexchange.DataExchange, identifier string) (K
 // DatasyncListTags lists datasync service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DatasyncListTags(conn *datasync.DataSync, identifier string) (KeyValueTags, error) {
+func DatasyncListTags(conn *datasync.DataSync, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &datasync.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -919,12 +929,12 @@ func DatasyncListTags(conn *datasync.DataSync, identifier string) (KeyValueTags,
 // DaxListTags lists dax service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DaxListTags(conn *dax.DAX, identifier string) (KeyValueTags, error) {
+func DaxListTags(conn *dax.DAX, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &dax.ListTagsInput{
 		ResourceName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -943,12 +953,12 @@ func DaxListTags(conn *dax.DAX, identifier string) (KeyValueTags, error) {
 // DevicefarmListTags lists devicefarm service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DevicefarmListTags(conn *devicefarm.DeviceFarm, identifier string) (KeyValueTags, error) {
+func DevicefarmListTags(conn *devicefarm.DeviceFarm, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &devicefarm.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -967,12 +977,12 @@ func DevicefarmListTags(conn *devicefarm.DeviceFarm, identifier string) (KeyValu
 // DirectconnectListTags lists directconnect service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DirectconnectListTags(conn *directconnect.DirectConnect, identifier string) (KeyValueTags, error) {
+func DirectconnectListTags(conn *directconnect.DirectConnect, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &directconnect.DescribeTagsInput{
 		ResourceArns: aws.StringSlice([]string{identifier}),
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -991,12 +1001,12 @@ func DirectconnectListTags(conn *directconnect.DirectConnect, identifier string)
 // DirectoryserviceListTags lists directoryservice service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DirectoryserviceListTags(conn *directoryservice.DirectoryService, identifier string) (KeyValueTags, error) {
+func DirectoryserviceListTags(conn *directoryservice.DirectoryService, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &directoryservice.ListTagsForResourceInput{
 		ResourceId: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1015,12 +1025,12 @@ func DirectoryserviceListTags(conn *directoryservice.DirectoryService, identifie
 // DlmListTags lists dlm service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DlmListTags(conn *dlm.DLM, identifier string) (KeyValueTags, error) {
+func DlmListTags(conn *dlm.DLM, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &dlm.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1039,12 +1049,12 @@ func DlmListTags(conn *dlm.DLM, identifier string) (KeyValueTags, error) {
 // DocdbListTags lists docdb service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DocdbListTags(conn *docdb.DocDB, identifier string) (KeyValueTags, error) {
+func DocdbListTags(conn *docdb.DocDB, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &docdb.ListTagsForResourceInput{
 		ResourceName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1063,12 +1073,12 @@ func DocdbListTags(conn *docdb.DocDB, identifier string) (KeyValueTags, error) {
 // DynamodbListTags lists dynamodb service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func DynamodbListTags(conn *dynamodb.DynamoDB, identifier string) (KeyValueTags, error) {
+func DynamodbListTags(conn *dynamodb.DynamoDB, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &dynamodb.ListTagsOfResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsOfResource(input)
+	output, err := conn.ListTagsOfResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1087,7 +1097,7 @@ func DynamodbListTags(conn *dynamodb.DynamoDB, identifier string) (KeyValueTags,
 // Ec2ListTags lists ec2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Ec2ListTags(conn *ec2.EC2, identifier string) (KeyValueTags, error) {
+func Ec2ListTags(conn *ec2.EC2, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &ec2.DescribeTagsInput{
 		Filters: []*ec2.Filter{
 			{
@@ -1097,7 +1107,7 @@ func Ec2ListTags(conn *ec2.EC2, identifier string) (KeyValueTags, error) {
 		},
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeContains(err, ".NotFound") {
 		err = &resource.NotFoundError{
@@ -1116,12 +1126,12 @@ func Ec2ListTags(conn *ec2.EC2, identifier string) (KeyValueTags, error) {
 // EcrListTags lists ecr service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func EcrListTags(conn *ecr.ECR, identifier string) (KeyValueTags, error) {
+func EcrListTags(conn *ecr.ECR, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &ecr.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1140,12 +1150,12 @@ func EcrListTags(conn *ecr.ECR, identifier string) (KeyValueTags, error) {
 // EcsListTags lists ecs service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func EcsListTags(conn *ecs.ECS, identifier string) (KeyValueTags, error) {
+func EcsListTags(conn *ecs.ECS, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &ecs.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrMessageContains(err, "InvalidParameterException", "The specified cluster is inactive. Specify an active cluster and try again.") {
 		err = &resource.NotFoundError{
@@ -1164,12 +1174,12 @@ func EcsListTags(conn *ecs.ECS, identifier string) (KeyValueTags, error) {
 // EfsListTags lists efs service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func EfsListTags(conn *efs.EFS, identifier string) (KeyValueTags, error) {
+func EfsListTags(conn *efs.EFS, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &efs.DescribeTagsInput{
 		FileSystemId: aws.String(identifier),
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1188,12 +1198,12 @@ func EfsListTags(conn *efs.EFS, identifier string) (KeyValueTags, error) {
 // EksListTags lists eks service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func EksListTags(conn *eks.EKS, identifier string) (KeyValueTags, error) {
+func EksListTags(conn *eks.EKS, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &eks.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1212,12 +1222,12 @@ func EksListTags(conn *eks.EKS, identifier string) (KeyValueTags, error) {
 // ElasticacheListTags lists elasticache service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ElasticacheListTags(conn *elasticache.ElastiCache, identifier string) (KeyValueTags, error) {
+func ElasticacheListTags(conn *elasticache.ElastiCache, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &elasticache.ListTagsForResourceInput{
 		ResourceName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1236,12 +1246,12 @@ func ElasticacheListTags(conn *elasticache.ElastiCache, identifier string) (KeyV
 // ElasticbeanstalkListTags lists elasticbeanstalk service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ElasticbeanstalkListTags(conn *elasticbeanstalk.ElasticBeanstalk, identifier string) (KeyValueTags, error) {
+func ElasticbeanstalkListTags(conn *elasticbeanstalk.ElasticBeanstalk, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &elasticbeanstalk.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1260,12 +1270,12 @@ func ElasticbeanstalkListTags(conn *elasticbeanstalk.ElasticBeanstalk, identifie
 // ElasticsearchserviceListTags lists elasticsearchservice service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ElasticsearchserviceListTags(conn *elasticsearchservice.ElasticsearchService, identifier string) (KeyValueTags, error) {
+func ElasticsearchserviceListTags(conn *elasticsearchservice.ElasticsearchService, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &elasticsearchservice.ListTagsInput{
 		ARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1284,12 +1294,12 @@ func ElasticsearchserviceListTags(conn *elasticsearchservice.ElasticsearchServic
 // ElbListTags lists elb service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ElbListTags(conn *elb.ELB, identifier string) (KeyValueTags, error) {
+func ElbListTags(conn *elb.ELB, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &elb.DescribeTagsInput{
 		LoadBalancerNames: aws.StringSlice([]string{identifier}),
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1308,12 +1318,12 @@ func ElbListTags(conn *elb.ELB, identifier string) (KeyValueTags, error) {
 // Elbv2ListTags lists elbv2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Elbv2ListTags(conn *elbv2.ELBV2, identifier string) (KeyValueTags, error) {
+func Elbv2ListTags(conn *elbv2.ELBV2, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &elbv2.DescribeTagsInput{
 		ResourceArns: aws.StringSlice([]string{identifier}),
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1332,12 +1342,12 @@ func Elbv2ListTags(conn *elbv2.ELBV2, identifier string) (KeyValueTags, error) {
 // FirehoseListTags lists firehose service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func FirehoseListTags(conn *firehose.Firehose, identifier string) (KeyValueTags, error) {
+func FirehoseListTags(conn *firehose.Firehose, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &firehose.ListTagsForDeliveryStreamInput{
 		DeliveryStreamName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForDeliveryStream(input)
+	output, err := conn.ListTagsForDeliveryStreamWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1356,12 +1366,12 @@ func FirehoseListTags(conn *firehose.Firehose, identifier string) (KeyValueTags,
 // FsxListTags lists fsx service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func FsxListTags(conn *fsx.FSx, identifier string) (KeyValueTags, error) {
+func FsxListTags(conn *fsx.FSx, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &fsx.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1380,12 +1390,12 @@ func FsxListTags(conn *fsx.FSx, identifier string) (KeyValueTags, error) {
 // GameliftListTags lists gamelift service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func GameliftListTags(conn *gamelift.GameLift, identifier string) (KeyValueTags, error) {
+func GameliftListTags(conn *gamelift.GameLift, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &gamelift.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1404,12 +1414,12 @@ func GameliftListTags(conn *gamelift.GameLift, identifier string) (KeyValueTags,
 // GlacierListTags lists glacier service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func GlacierListTags(conn *glacier.Glacier, identifier string) (KeyValueTags, error) {
+func GlacierListTags(conn *glacier.Glacier, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &glacier.ListTagsForVaultInput{
 		VaultName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForVault(input)
+	output, err := conn.ListTagsForVaultWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1428,12 +1438,12 @@ func GlacierListTags(conn *glacier.Glacier, identifier string) (KeyValueTags, er
 // GlobalacceleratorListTags lists globalaccelerator service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func GlobalacceleratorListTags(conn *globalaccelerator.GlobalAccelerator, identifier string) (KeyValueTags, error) {
+func GlobalacceleratorListTags(conn *globalaccelerator.GlobalAccelerator, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &globalaccelerator.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1452,12 +1462,12 @@ func GlobalacceleratorListTags(conn *globalaccelerator.GlobalAccelerator, identi
 // GlueListTags lists glue service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func GlueListTags(conn *glue.Glue, identifier string) (KeyValueTags, error) {
+func GlueListTags(conn *glue.Glue, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &glue.GetTagsInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.GetTags(input)
+	output, err := conn.GetTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1476,12 +1486,12 @@ func GlueListTags(conn *glue.Glue, identifier string) (KeyValueTags, error) {
 // GreengrassListTags lists greengrass service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func GreengrassListTags(conn *greengrass.Greengrass, identifier string) (KeyValueTags, error) {
+func GreengrassListTags(conn *greengrass.Greengrass, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &greengrass.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1500,12 +1510,12 @@ func GreengrassListTags(conn *greengrass.Greengrass, identifier string) (KeyValu
 // GuarddutyListTags lists guardduty service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func GuarddutyListTags(conn *guardduty.GuardDuty, identifier string) (KeyValueTags, error) {
+func GuarddutyListTags(conn *guardduty.GuardDuty, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &guardduty.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1521,15 +1531,39 @@ func GuarddutyListTags(conn *guardduty.GuardDuty, identifier string) (KeyValueTa
 	return GuarddutyKeyValueTags(output.Tags), nil
 }
 
+// HealthlakeListTags lists healthlake service tags.
+// The identifier is typically the Amazon Resource Name (ARN), although
+// it may also be a different identifier depending on the service.
+func HealthlakeListTags(conn *healthlake.HealthLake, identifier string, opts ...request.Option) (KeyValueTags, error) {
+	input := &healthlake.ListTagsForResourceInput{
+		ResourceARN: aws.String(identifier),
+	}
+
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
+
+	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
+		err = &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return New(nil), err
+	}
+
+	return HealthlakeKeyValueTags(output.Tags), nil
+}
+
 // ImagebuilderListTags lists imagebuilder service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ImagebuilderListTags(conn *imagebuilder.Imagebuilder, identifier string) (KeyValueTags, error) {
+func ImagebuilderListTags(conn *imagebuilder.Imagebuilder, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &imagebuilder.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1548,12 +1582,12 @@ func ImagebuilderListTags(conn *imagebuilder.Imagebuilder, identifier string) (K
 // InspectorListTags lists inspector service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func InspectorListTags(conn *inspector.Inspector, identifier string) (KeyValueTags, error) {
+func InspectorListTags(conn *inspector.Inspector, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &inspector.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1572,12 +1606,12 @@ func InspectorListTags(conn *inspector.Inspector, identifier string) (KeyValueTa
 // IotListTags lists iot service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func IotListTags(conn *iot.IoT, identifier string) (KeyValueTags, error) {
+func IotListTags(conn *iot.IoT, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &iot.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1596,12 +1630,12 @@ func IotListTags(conn *iot.IoT, identifier string) (KeyValueTags, error) {
 // IotanalyticsListTags lists iotanalytics service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func IotanalyticsListTags(conn *iotanalytics.IoTAnalytics, identifier string) (KeyValueTags, error) {
+func IotanalyticsListTags(conn *iotanalytics.IoTAnalytics, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &iotanalytics.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1620,12 +1654,12 @@ func IotanalyticsListTags(conn *iotanalytics.IoTAnalytics, identifier string) (K
 // IoteventsListTags lists iotevents service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func IoteventsListTags(conn *iotevents.IoTEvents, identifier string) (KeyValueTags, error) {
+func IoteventsListTags(conn *iotevents.IoTEvents, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &iotevents.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1644,12 +1678,12 @@ func IoteventsListTags(conn *iotevents.IoTEvents, identifier string) (KeyValueTa
 // KafkaListTags lists kafka service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func KafkaListTags(conn *kafka.Kafka, identifier string) (KeyValueTags, error) {
+func KafkaListTags(conn *kafka.Kafka, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &kafka.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1668,12 +1702,12 @@ func KafkaListTags(conn *kafka.Kafka, identifier string) (KeyValueTags, error) {
 // KinesisListTags lists kinesis service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func KinesisListTags(conn *kinesis.Kinesis, identifier string) (KeyValueTags, error) {
+func KinesisListTags(conn *kinesis.Kinesis, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &kinesis.ListTagsForStreamInput{
 		StreamName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForStream(input)
+	output, err := conn.ListTagsForStreamWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1692,12 +1726,12 @@ func KinesisListTags(conn *kinesis.Kinesis, identifier string) (KeyValueTags, er
 // KinesisanalyticsListTags lists kinesisanalytics service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func KinesisanalyticsListTags(conn *kinesisanalytics.KinesisAnalytics, identifier string) (KeyValueTags, error) {
+func KinesisanalyticsListTags(conn *kinesisanalytics.KinesisAnalytics, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &kinesisanalytics.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1716,12 +1750,12 @@ func KinesisanalyticsListTags(conn *kinesisanalytics.KinesisAnalytics, identifie
 // Kinesisanalyticsv2ListTags lists kinesisanalyticsv2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Kinesisanalyticsv2ListTags(conn *kinesisanalyticsv2.KinesisAnalyticsV2, identifier string) (KeyValueTags, error) {
+func Kinesisanalyticsv2ListTags(conn *kinesisanalyticsv2.KinesisAnalyticsV2, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &kinesisanalyticsv2.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1740,12 +1774,12 @@ func Kinesisanalyticsv2ListTags(conn *kinesisanalyticsv2.KinesisAnalyticsV2, ide
 // KinesisvideoListTags lists kinesisvideo service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func KinesisvideoListTags(conn *kinesisvideo.KinesisVideo, identifier string) (KeyValueTags, error) {
+func KinesisvideoListTags(conn *kinesisvideo.KinesisVideo, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &kinesisvideo.ListTagsForStreamInput{
 		StreamARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForStream(input)
+	output, err := conn.ListTagsForStreamWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1764,12 +1798,12 @@ func KinesisvideoListTags(conn *kinesisvideo.KinesisVideo, identifier string) (K
 // KmsListTags lists kms service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func KmsListTags(conn *kms.KMS, identifier string) (KeyValueTags, error) {
+func KmsListTags(conn *kms.KMS, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &kms.ListResourceTagsInput{
 		KeyId: aws.String(identifier),
 	}
 
-	output, err := conn.ListResourceTags(input)
+	output, err := conn.ListResourceTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1788,12 +1822,12 @@ func KmsListTags(conn *kms.KMS, identifier string) (KeyValueTags, error) {
 // LambdaListTags lists lambda service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func LambdaListTags(conn *lambda.Lambda, identifier string) (KeyValueTags, error) {
+func LambdaListTags(conn *lambda.Lambda, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &lambda.ListTagsInput{
 		Resource: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1812,12 +1846,12 @@ func LambdaListTags(conn *lambda.Lambda, identifier string) (KeyValueTags, error
 // LicensemanagerListTags lists licensemanager service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func LicensemanagerListTags(conn *licensemanager.LicenseManager, identifier string) (KeyValueTags, error) {
+func LicensemanagerListTags(conn *licensemanager.LicenseManager, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &licensemanager.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1836,12 +1870,12 @@ func LicensemanagerListTags(conn *licensemanager.LicenseManager, identifier stri
 // MediaconnectListTags lists mediaconnect service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func MediaconnectListTags(conn *mediaconnect.MediaConnect, identifier string) (KeyValueTags, error) {
+func MediaconnectListTags(conn *mediaconnect.MediaConnect, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &mediaconnect.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1860,12 +1894,12 @@ func MediaconnectListTags(conn *mediaconnect.MediaConnect, identifier string) (K
 // MediaconvertListTags lists mediaconvert service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func MediaconvertListTags(conn *mediaconvert.MediaConvert, identifier string) (KeyValueTags, error) {
+func MediaconvertListTags(conn *mediaconvert.MediaConvert, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &mediaconvert.ListTagsForResourceInput{
 		Arn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1884,12 +1918,12 @@ func MediaconvertListTags(conn *mediaconvert.MediaConvert, identifier string) (K
 // MedialiveListTags lists medialive service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func MedialiveListTags(conn *medialive.MediaLive, identifier string) (KeyValueTags, error) {
+func MedialiveListTags(conn *medialive.MediaLive, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &medialive.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1908,12 +1942,12 @@ func MedialiveListTags(conn *medialive.MediaLive, identifier string) (KeyValueTa
 // MediapackageListTags lists mediapackage service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func MediapackageListTags(conn *mediapackage.MediaPackage, identifier string) (KeyValueTags, error) {
+func MediapackageListTags(conn *mediapackage.MediaPackage, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &mediapackage.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1932,12 +1966,12 @@ func MediapackageListTags(conn *mediapackage.MediaPackage, identifier string) (K
 // MediastoreListTags lists mediastore service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func MediastoreListTags(conn *mediastore.MediaStore, identifier string) (KeyValueTags, error) {
+func MediastoreListTags(conn *mediastore.MediaStore, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &mediastore.ListTagsForResourceInput{
 		Resource: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1956,12 +1990,12 @@ func MediastoreListTags(conn *mediastore.MediaStore, identifier string) (KeyValu
 // MqListTags lists mq service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func MqListTags(conn *mq.MQ, identifier string) (KeyValueTags, error) {
+func MqListTags(conn *mq.MQ, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &mq.ListTagsInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -1980,12 +2014,12 @@ func MqListTags(conn *mq.MQ, identifier string) (KeyValueTags, error) {
 // NeptuneListTags lists neptune service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func NeptuneListTags(conn *neptune.Neptune, identifier string) (KeyValueTags, error) {
+func NeptuneListTags(conn *neptune.Neptune, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &neptune.ListTagsForResourceInput{
 		ResourceName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2004,12 +2038,12 @@ func NeptuneListTags(conn *neptune.Neptune, identifier string) (KeyValueTags, er
 // NetworkfirewallListTags lists networkfirewall service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func NetworkfirewallListTags(conn *networkfirewall.NetworkFirewall, identifier string) (KeyValueTags, error) {
+func NetworkfirewallListTags(conn *networkfirewall.NetworkFirewall, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &networkfirewall.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2028,12 +2062,12 @@ func NetworkfirewallListTags(conn *networkfirewall.NetworkFirewall, identifier s
 // NetworkmanagerListTags lists networkmanager service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func NetworkmanagerListTags(conn *networkmanager.NetworkManager, identifier string) (KeyValueTags, error) {
+func NetworkmanagerListTags(conn *networkmanager.NetworkManager, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &networkmanager.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2052,12 +2086,12 @@ func NetworkmanagerListTags(conn *networkmanager.NetworkManager, identifier stri
 // OpsworksListTags lists opsworks service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func OpsworksListTags(conn *opsworks.OpsWorks, identifier string) (KeyValueTags, error) {
+func OpsworksListTags(conn *opsworks.OpsWorks, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &opsworks.ListTagsInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	output, err := conn.ListTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2076,12 +2110,12 @@ func OpsworksListTags(conn *opsworks.OpsWorks, identifier string) (KeyValueTags,
 // OrganizationsListTags lists organizations service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func OrganizationsListTags(conn *organizations.Organizations, identifier string) (KeyValueTags, error) {
+func OrganizationsListTags(conn *organizations.Organizations, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &organizations.ListTagsForResourceInput{
 		ResourceId: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2100,12 +2134,12 @@ func OrganizationsListTags(conn *organizations.Organizations, identifier string)
 // PinpointListTags lists pinpoint service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func PinpointListTags(conn *pinpoint.Pinpoint, identifier string) (KeyValueTags, error) {
+func PinpointListTags(conn *pinpoint.Pinpoint, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &pinpoint.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2124,12 +2158,12 @@ func PinpointListTags(conn *pinpoint.Pinpoint, identifier string) (KeyValueTags,
 // QldbListTags lists qldb service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func QldbListTags(conn *qldb.QLDB, identifier string) (KeyValueTags, error) {
+func QldbListTags(conn *qldb.QLDB, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &qldb.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2148,12 +2182,12 @@ func QldbListTags(conn *qldb.QLDB, identifier string) (KeyValueTags, error) {
 // QuicksightListTags lists quicksight service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func QuicksightListTags(conn *quicksight.QuickSight, identifier string) (KeyValueTags, error) {
+func QuicksightListTags(conn *quicksight.QuickSight, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &quicksight.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2172,12 +2206,12 @@ func QuicksightListTags(conn *quicksight.QuickSight, identifier string) (KeyValu
 // RdsListTags lists rds service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func RdsListTags(conn *rds.RDS, identifier string) (KeyValueTags, error) {
+func RdsListTags(conn *rds.RDS, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &rds.ListTagsForResourceInput{
 		ResourceName: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2196,12 +2230,12 @@ func RdsListTags(conn *rds.RDS, identifier string) (KeyValueTags, error) {
 // ResourcegroupsListTags lists resourcegroups service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ResourcegroupsListTags(conn *resourcegroups.ResourceGroups, identifier string) (KeyValueTags, error) {
+func ResourcegroupsListTags(conn *resourcegroups.ResourceGroups, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &resourcegroups.GetTagsInput{
 		Arn: aws.String(identifier),
 	}
 
-	output, err := conn.GetTags(input)
+	output, err := conn.GetTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2220,13 +2254,18 @@ func ResourcegroupsListTags(conn *resourcegroups.ResourceGroups, identifier stri
 // Route53ListTags lists route53 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Route53ListTags(conn *route53.Route53, identifier string, resourceType string) (KeyValueTags, error) {
+// The resourceType (ResourceType) is required and must not be empty.
+func Route53ListTags(conn *route53.Route53, identifier string, resourceType string, opts ...request.Option) (KeyValueTags, error) {
+	if resourceType == "" {
+		return New(nil), fmt.Errorf("ResourceType is required to list route53 tags for (%s)", identifier)
+	}
+
 	input := &route53.ListTagsForResourceInput{
 		ResourceId:   aws.String(identifier),
 		ResourceType: aws.String(resourceType),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2245,12 +2284,12 @@ func Route53ListTags(conn *route53.Route53, identifier string, resourceType stri
 // Route53recoveryreadinessListTags lists route53recoveryreadiness service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Route53recoveryreadinessListTags(conn *route53recoveryreadiness.Route53RecoveryReadiness, identifier string) (KeyValueTags, error) {
+func Route53recoveryreadinessListTags(conn *route53recoveryreadiness.Route53RecoveryReadiness, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &route53recoveryreadiness.ListTagsForResourcesInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResources(input)
+	output, err := conn.ListTagsForResourcesWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2269,12 +2308,12 @@ func Route53recoveryreadinessListTags(conn *route53recoveryreadiness.Route53Reco
 // Route53resolverListTags lists route53resolver service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Route53resolverListTags(conn *route53resolver.Route53Resolver, identifier string) (KeyValueTags, error) {
+func Route53resolverListTags(conn *route53resolver.Route53Resolver, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &route53resolver.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2293,12 +2332,22 @@ func Route53resolverListTags(conn *route53resolver.Route53Resolver, identifier s
 // SagemakerListTags lists sagemaker service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SagemakerListTags(conn *sagemaker.SageMaker, identifier string) (KeyValueTags, error) {
+func SagemakerListTags(conn *sagemaker.SageMaker, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &sagemaker.ListTagsInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTags(input)
+	var tags []*sagemaker.Tag
+
+	err := conn.ListTagsPagesWithContext(context.Background(), input, func(page *sagemaker.ListTagsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		tags = append(tags, page.Tags...)
+
+		return !lastPage
+	}, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2311,18 +2360,18 @@ func SagemakerListTags(conn *sagemaker.SageMaker, identifier string) (KeyValueTa
 		return New(nil), err
 	}
 
-	return SagemakerKeyValueTags(output.Tags), nil
+	return SagemakerKeyValueTags(tags), nil
 }
 
 // SchemasListTags lists schemas service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SchemasListTags(conn *schemas.Schemas, identifier string) (KeyValueTags, error) {
+func SchemasListTags(conn *schemas.Schemas, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &schemas.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2341,12 +2390,12 @@ func SchemasListTags(conn *schemas.Schemas, identifier string) (KeyValueTags, er
 // SecurityhubListTags lists securityhub service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SecurityhubListTags(conn *securityhub.SecurityHub, identifier string) (KeyValueTags, error) {
+func SecurityhubListTags(conn *securityhub.SecurityHub, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &securityhub.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2365,12 +2414,12 @@ func SecurityhubListTags(conn *securityhub.SecurityHub, identifier string) (KeyV
 // ServicediscoveryListTags lists servicediscovery service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ServicediscoveryListTags(conn *servicediscovery.ServiceDiscovery, identifier string) (KeyValueTags, error) {
+func ServicediscoveryListTags(conn *servicediscovery.ServiceDiscovery, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &servicediscovery.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2389,12 +2438,12 @@ func ServicediscoveryListTags(conn *servicediscovery.ServiceDiscovery, identifie
 // SfnListTags lists sfn service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SfnListTags(conn *sfn.SFN, identifier string) (KeyValueTags, error) {
+func SfnListTags(conn *sfn.SFN, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &sfn.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2413,12 +2462,12 @@ func SfnListTags(conn *sfn.SFN, identifier string) (KeyValueTags, error) {
 // ShieldListTags lists shield service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func ShieldListTags(conn *shield.Shield, identifier string) (KeyValueTags, error) {
+func ShieldListTags(conn *shield.Shield, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &shield.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2437,12 +2486,12 @@ func ShieldListTags(conn *shield.Shield, identifier string) (KeyValueTags, error
 // SignerListTags lists signer service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SignerListTags(conn *signer.Signer, identifier string) (KeyValueTags, error) {
+func SignerListTags(conn *signer.Signer, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &signer.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2461,12 +2510,12 @@ func SignerListTags(conn *signer.Signer, identifier string) (KeyValueTags, error
 // SnsListTags lists sns service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SnsListTags(conn *sns.SNS, identifier string) (KeyValueTags, error) {
+func SnsListTags(conn *sns.SNS, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &sns.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2485,12 +2534,12 @@ func SnsListTags(conn *sns.SNS, identifier string) (KeyValueTags, error) {
 // SqsListTags lists sqs service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SqsListTags(conn *sqs.SQS, identifier string) (KeyValueTags, error) {
+func SqsListTags(conn *sqs.SQS, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &sqs.ListQueueTagsInput{
 		QueueUrl: aws.String(identifier),
 	}
 
-	output, err := conn.ListQueueTags(input)
+	output, err := conn.ListQueueTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2509,13 +2558,18 @@ func SqsListTags(conn *sqs.SQS, identifier string) (KeyValueTags, error) {
 // SsmListTags lists ssm service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SsmListTags(conn *ssm.SSM, identifier string, resourceType string) (KeyValueTags, error) {
+// The resourceType (ResourceType) is required and must not be empty.
+func SsmListTags(conn *ssm.SSM, identifier string, resourceType string, opts ...request.Option) (KeyValueTags, error) {
+	if resourceType == "" {
+		return New(nil), fmt.Errorf("ResourceType is required to list ssm tags for (%s)", identifier)
+	}
+
 	input := &ssm.ListTagsForResourceInput{
 		ResourceId:   aws.String(identifier),
 		ResourceType: aws.String(resourceType),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2534,13 +2588,18 @@ func SsmListTags(conn *ssm.SSM, identifier string, resourceType string) (KeyValu
 // SsoadminListTags lists ssoadmin service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SsoadminListTags(conn *ssoadmin.SSOAdmin, identifier string, resourceType string) (KeyValueTags, error) {
+// The resourceType (InstanceArn) is required and must not be empty.
+func SsoadminListTags(conn *ssoadmin.SSOAdmin, identifier string, resourceType string, opts ...request.Option) (KeyValueTags, error) {
+	if resourceType == "" {
+		return New(nil), fmt.Errorf("InstanceArn is required to list ssoadmin tags for (%s)", identifier)
+	}
+
 	input := &ssoadmin.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 		InstanceArn: aws.String(resourceType),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2559,12 +2618,12 @@ func SsoadminListTags(conn *ssoadmin.SSOAdmin, identifier string, resourceType s
 // StoragegatewayListTags lists storagegateway service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func StoragegatewayListTags(conn *storagegateway.StorageGateway, identifier string) (KeyValueTags, error) {
+func StoragegatewayListTags(conn *storagegateway.StorageGateway, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &storagegateway.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2583,12 +2642,12 @@ func StoragegatewayListTags(conn *storagegateway.StorageGateway, identifier stri
 // SwfListTags lists swf service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func SwfListTags(conn *swf.SWF, identifier string) (KeyValueTags, error) {
+func SwfListTags(conn *swf.SWF, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &swf.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2607,12 +2666,12 @@ func SwfListTags(conn *swf.SWF, identifier string) (KeyValueTags, error) {
 // TimestreamwriteListTags lists timestreamwrite service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func TimestreamwriteListTags(conn *timestreamwrite.TimestreamWrite, identifier string) (KeyValueTags, error) {
+func TimestreamwriteListTags(conn *timestreamwrite.TimestreamWrite, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &timestreamwrite.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2631,12 +2690,12 @@ func TimestreamwriteListTags(conn *timestreamwrite.TimestreamWrite, identifier s
 // TransferListTags lists transfer service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func TransferListTags(conn *transfer.Transfer, identifier string) (KeyValueTags, error) {
+func TransferListTags(conn *transfer.Transfer, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &transfer.ListTagsForResourceInput{
 		Arn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2655,12 +2714,12 @@ func TransferListTags(conn *transfer.Transfer, identifier string) (KeyValueTags,
 // WafListTags lists waf service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func WafListTags(conn *waf.WAF, identifier string) (KeyValueTags, error) {
+func WafListTags(conn *waf.WAF, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &waf.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2679,12 +2738,12 @@ func WafListTags(conn *waf.WAF, identifier string) (KeyValueTags, error) {
 // WafregionalListTags lists wafregional service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func WafregionalListTags(conn *wafregional.WAFRegional, identifier string) (KeyValueTags, error) {
+func WafregionalListTags(conn *wafregional.WAFRegional, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &waf.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2703,12 +2762,12 @@ func WafregionalListTags(conn *wafregional.WAFRegional, identifier string) (KeyV
 // Wafv2ListTags lists wafv2 service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func Wafv2ListTags(conn *wafv2.WAFV2, identifier string) (KeyValueTags, error) {
+func Wafv2ListTags(conn *wafv2.WAFV2, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &wafv2.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2727,12 +2786,12 @@ func Wafv2ListTags(conn *wafv2.WAFV2, identifier string) (KeyValueTags, error) {
 // WorklinkListTags lists worklink service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func WorklinkListTags(conn *worklink.WorkLink, identifier string) (KeyValueTags, error) {
+func WorklinkListTags(conn *worklink.WorkLink, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &worklink.ListTagsForResourceInput{
 		ResourceArn: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2751,12 +2810,12 @@ func WorklinkListTags(conn *worklink.WorkLink, identifier string) (KeyValueTags,
 // WorkspacesListTags lists workspaces service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func WorkspacesListTags(conn *workspaces.WorkSpaces, identifier string) (KeyValueTags, error) {
+func WorkspacesListTags(conn *workspaces.WorkSpaces, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &workspaces.DescribeTagsInput{
 		ResourceId: aws.String(identifier),
 	}
 
-	output, err := conn.DescribeTags(input)
+	output, err := conn.DescribeTagsWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
@@ -2775,12 +2834,12 @@ func WorkspacesListTags(conn *workspaces.WorkSpaces, identifier string) (KeyValu
 // XrayListTags lists xray service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func XrayListTags(conn *xray.XRay, identifier string) (KeyValueTags, error) {
+func XrayListTags(conn *xray.XRay, identifier string, opts ...request.Option) (KeyValueTags, error) {
 	input := &xray.ListTagsForResourceInput{
 		ResourceARN: aws.String(identifier),
 	}
 
-	output, err := conn.ListTagsForResource(input)
+	output, err := conn.ListTagsForResourceWithContext(context.Background(), input, opts...)
 
 	if tfawserr.ErrCodeEquals(err, "ResourceNotFoundException") {
 		err = &resource.NotFoundError{
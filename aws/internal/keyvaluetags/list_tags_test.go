@@ -0,0 +1,146 @@
+package keyvaluetags
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+)
+
+func TestListTagsRequiresResourceType(t *testing.T) {
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	failOnSend := func(r *request.Request) {
+		t.Errorf("unexpected %s API call", r.Operation.Name)
+	}
+
+	route53conn := route53.New(sess)
+	route53conn.Handlers.Clear()
+	route53conn.Handlers.Send.PushBack(failOnSend)
+
+	ssmconn := ssm.New(sess)
+	ssmconn.Handlers.Clear()
+	ssmconn.Handlers.Send.PushBack(failOnSend)
+
+	ssoadminconn := ssoadmin.New(sess)
+	ssoadminconn.Handlers.Clear()
+	ssoadminconn.Handlers.Send.PushBack(failOnSend)
+
+	testCases := []struct {
+		name          string
+		listTags      func() (KeyValueTags, error)
+		expectedError *regexp.Regexp
+	}{
+		{
+			name: "route53",
+			listTags: func() (KeyValueTags, error) {
+				return Route53ListTags(route53conn, "Z1234567890", "")
+			},
+			expectedError: regexp.MustCompile(`^ResourceType is required to list route53 tags`),
+		},
+		{
+			name: "ssm",
+			listTags: func() (KeyValueTags, error) {
+				return SsmListTags(ssmconn, "test-parameter", "")
+			},
+			expectedError: regexp.MustCompile(`^ResourceType is required to list ssm tags`),
+		},
+		{
+			name: "ssoadmin",
+			listTags: func() (KeyValueTags, error) {
+				return SsoadminListTags(ssoadminconn, "arn:aws:sso:::permissionSet/ssoins-1234567890abcdef/ps-1234567890abcdef", "")
+			},
+			expectedError: regexp.MustCompile(`^InstanceArn is required to list ssoadmin tags`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := testCase.listTags()
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got: %s", testCase.expectedError, err)
+			}
+
+			if len(got) != 0 {
+				t.Errorf("expected no tags, got: %s", got)
+			}
+		})
+	}
+}
+
+func TestSagemakerListTagsPaginated(t *testing.T) {
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	pages := map[string]*sagemaker.ListTagsOutput{
+		"": {
+			NextToken: aws.String("page2"),
+			Tags: []*sagemaker.Tag{
+				{Key: aws.String("key1"), Value: aws.String("value1")},
+				{Key: aws.String("key2"), Value: aws.String("value2")},
+			},
+		},
+		"page2": {
+			NextToken: aws.String("page3"),
+			Tags: []*sagemaker.Tag{
+				{Key: aws.String("key3"), Value: aws.String("value3")},
+			},
+		},
+		"page3": {
+			Tags: []*sagemaker.Tag{
+				{Key: aws.String("key4"), Value: aws.String("value4")},
+			},
+		},
+	}
+
+	var calls int
+
+	conn := sagemaker.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		calls++
+
+		page, ok := pages[aws.StringValue(r.Params.(*sagemaker.ListTagsInput).NextToken)]
+
+		if !ok {
+			t.Fatalf("unexpected NextToken: %s", aws.StringValue(r.Params.(*sagemaker.ListTagsInput).NextToken))
+		}
+
+		*r.Data.(*sagemaker.ListTagsOutput) = *page
+	})
+
+	got, err := SagemakerListTags(conn, "arn:aws:sagemaker:us-west-2:123456789012:endpoint-config/test")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != len(pages) {
+		t.Errorf("expected %d ListTags calls, got %d", len(pages), calls)
+	}
+
+	testKeyValueTagsVerifyMap(t, got.Map(), map[string]string{
+		"key1": "value1",
+		"key2": "value2",
+		"key3": "value3",
+		"key4": "value4",
+	})
+}
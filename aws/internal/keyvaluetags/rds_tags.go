@@ -0,0 +1,59 @@
+//go:build !generate
+// +build !generate
+
+package keyvaluetags
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/go-multierror"
+)
+
+// rdsBatchListTagsConcurrency is the maximum number of concurrent ListTagsForResource calls.
+const rdsBatchListTagsConcurrency = 10
+
+// Custom RDS tag service functions using the same format as generated code.
+
+// RdsBatchListTags lists rds service tags for multiple resources concurrently.
+// The identifiers are the resource ARNs. Tags are returned for every identifier
+// that could be listed, along with the combined errors for those that could not.
+func RdsBatchListTags(conn *rds.RDS, identifiers []string) (map[string]KeyValueTags, error) {
+	var errs *multierror.Error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	result := make(map[string]KeyValueTags, len(identifiers))
+	semaphore := make(chan struct{}, rdsBatchListTagsConcurrency)
+
+	for _, identifier := range identifiers {
+		identifier := identifier
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			tags, err := RdsListTags(conn, identifier)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing tags for resource (%s): %w", identifier, err))
+				return
+			}
+
+			result[identifier] = tags
+		}()
+	}
+
+	wg.Wait()
+
+	return result, errs.ErrorOrNil()
+}
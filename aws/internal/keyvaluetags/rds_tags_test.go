@@ -0,0 +1,111 @@
+package keyvaluetags
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+func TestRdsBatchListTags(t *testing.T) {
+	testCases := []struct {
+		name        string
+		identifiers []string
+		want        map[string]map[string]string
+		wantErr     bool
+	}{
+		{
+			name: "empty",
+			want: map[string]map[string]string{},
+		},
+		{
+			name: "all found",
+			identifiers: []string{
+				"arn:aws:rds:us-west-2:123456789012:db-proxy:prx-1",
+				"arn:aws:rds:us-west-2:123456789012:db-proxy:prx-2",
+			},
+			want: map[string]map[string]string{
+				"arn:aws:rds:us-west-2:123456789012:db-proxy:prx-1": {"Name": "arn:aws:rds:us-west-2:123456789012:db-proxy:prx-1"},
+				"arn:aws:rds:us-west-2:123456789012:db-proxy:prx-2": {"Name": "arn:aws:rds:us-west-2:123456789012:db-proxy:prx-2"},
+			},
+		},
+		{
+			name: "partial error",
+			identifiers: []string{
+				"arn:aws:rds:us-west-2:123456789012:db-proxy:prx-1",
+				"error",
+			},
+			want: map[string]map[string]string{
+				"arn:aws:rds:us-west-2:123456789012:db-proxy:prx-1": {"Name": "arn:aws:rds:us-west-2:123456789012:db-proxy:prx-1"},
+			},
+			wantErr: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := rds.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var calls int32
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				atomic.AddInt32(&calls, 1)
+
+				identifier := aws.StringValue(r.Params.(*rds.ListTagsForResourceInput).ResourceName)
+
+				if identifier == "error" {
+					r.Error = awserr.New(rds.ErrCodeDBProxyNotFoundFault, "not found", nil)
+					return
+				}
+
+				data := r.Data.(*rds.ListTagsForResourceOutput)
+				data.TagList = []*rds.Tag{
+					{
+						Key:   aws.String("Name"),
+						Value: aws.String(identifier),
+					},
+				}
+			})
+
+			got, err := RdsBatchListTags(conn, testCase.identifiers)
+
+			if testCase.wantErr && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if int(calls) != len(testCase.identifiers) {
+				t.Errorf("expected %d API calls, got %d", len(testCase.identifiers), calls)
+			}
+
+			if len(got) != len(testCase.want) {
+				t.Fatalf("expected %d results, got %d", len(testCase.want), len(got))
+			}
+
+			for identifier, want := range testCase.want {
+				tags, ok := got[identifier]
+
+				if !ok {
+					t.Errorf("expected tags for %s", identifier)
+					continue
+				}
+
+				testKeyValueTagsVerifyMap(t, tags.Map(), want)
+			}
+		})
+	}
+}
@@ -68,6 +68,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/glue"
 	"github.com/aws/aws-sdk-go/service/greengrass"
 	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/aws/aws-sdk-go/service/healthlake"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -261,6 +262,8 @@ func ServiceClientType(serviceName string) string {
 		funcType = reflect.TypeOf(guardduty.New)
 	case "greengrass":
 		funcType = reflect.TypeOf(greengrass.New)
+	case "healthlake":
+		funcType = reflect.TypeOf(healthlake.New)
 	case "imagebuilder":
 		funcType = reflect.TypeOf(imagebuilder.New)
 	case "inspector":
@@ -489,6 +492,17 @@ func ServiceListTagsInputIdentifierField(serviceName string) string {
 	}
 }
 
+// ServiceListTagsPaginated determines if the service list tag function returns paginated results.
+// This causes the implementation to use the Pages function and collect tags from every page.
+func ServiceListTagsPaginated(serviceName string) string {
+	switch serviceName {
+	case "sagemaker":
+		return "yes"
+	default:
+		return ""
+	}
+}
+
 // ServiceListTagsInputIdentifierRequiresSlice determines if the service list tagging resource field requires a slice.
 func ServiceListTagsInputIdentifierRequiresSlice(serviceName string) string {
 	switch serviceName {
@@ -751,6 +765,8 @@ func ServiceTagInputIdentifierField(serviceName string) string {
 		return "ResourceARN"
 	case "glacier":
 		return "VaultName"
+	case "healthlake":
+		return "ResourceARN"
 	case "kinesis":
 		return "StreamName"
 	case "kinesisanalytics":
@@ -49,6 +49,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/fsx"
 	"github.com/aws/aws-sdk-go/service/gamelift"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go/service/healthlake"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -1860,6 +1861,33 @@ func GlobalacceleratorKeyValueTags(tags []*globalaccelerator.Tag) KeyValueTags {
 	return New(m)
 }
 
+// HealthlakeTags returns healthlake service tags.
+func (tags KeyValueTags) HealthlakeTags() []*healthlake.Tag {
+	result := make([]*healthlake.Tag, 0, len(tags))
+
+	for k, v := range tags.Map() {
+		tag := &healthlake.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		}
+
+		result = append(result, tag)
+	}
+
+	return result
+}
+
+// HealthlakeKeyValueTags creates KeyValueTags from healthlake service tags.
+func HealthlakeKeyValueTags(tags []*healthlake.Tag) KeyValueTags {
+	m := make(map[string]*string, len(tags))
+
+	for _, tag := range tags {
+		m[aws.StringValue(tag.Key)] = tag.Value
+	}
+
+	return New(m)
+}
+
 // IamTags returns iam service tags.
 func (tags KeyValueTags) IamTags() []*iam.Tag {
 	result := make([]*iam.Tag, 0, len(tags))
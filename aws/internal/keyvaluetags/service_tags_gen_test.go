@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/appmesh"
 	"github.com/aws/aws-sdk-go/service/athena"
 	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/healthlake"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 )
@@ -183,6 +184,104 @@ func TestKeyValueTagsAthenaTags(t *testing.T) {
 	}
 }
 
+func TestHealthlakeKeyValueTags(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags []*healthlake.Tag
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			tags: []*healthlake.Tag{},
+			want: map[string]string{},
+		},
+		{
+			name: "non_empty",
+			tags: []*healthlake.Tag{
+				{
+					Key:   aws.String("key1"),
+					Value: aws.String("value1"),
+				},
+				{
+					Key:   aws.String("key2"),
+					Value: aws.String("value2"),
+				},
+				{
+					Key:   aws.String("key3"),
+					Value: aws.String("value3"),
+				},
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := HealthlakeKeyValueTags(testCase.tags)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
+func TestKeyValueTagsHealthlakeTags(t *testing.T) {
+	testCases := []struct {
+		name string
+		tags KeyValueTags
+		want []*healthlake.Tag
+	}{
+		{
+			name: "empty",
+			tags: New(map[string]string{}),
+			want: []*healthlake.Tag{},
+		},
+		{
+			name: "non_empty",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			want: []*healthlake.Tag{
+				{
+					Key:   aws.String("key1"),
+					Value: aws.String("value1"),
+				},
+				{
+					Key:   aws.String("key2"),
+					Value: aws.String("value2"),
+				},
+				{
+					Key:   aws.String("key3"),
+					Value: aws.String("value3"),
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.HealthlakeTags()
+
+			gotMap := make(map[string]string, len(got))
+			for _, tag := range got {
+				gotMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+
+			wantMap := make(map[string]string, len(testCase.want))
+			for _, tag := range testCase.want {
+				wantMap[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+
+			testKeyValueTagsVerifyMap(t, gotMap, wantMap)
+		})
+	}
+}
+
 // []*SERVICE.Tag (with TagKey and TagValue fields) handling
 
 func TestKmsKeyValueTags(t *testing.T) {
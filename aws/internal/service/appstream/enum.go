@@ -0,0 +1,7 @@
+package appstream
+
+const (
+	// AgentVersionLatest requests the latest AppStream 2.0 agent version.
+	// The resolved version is returned when the image builder is described.
+	AgentVersionLatest = "LATEST"
+)
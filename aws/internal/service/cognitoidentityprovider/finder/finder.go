@@ -5,6 +5,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 // CognitoUserPoolUICustomization returns the UI Customization corresponding to the UserPoolId and ClientId.
@@ -33,3 +36,60 @@ func CognitoUserPoolUICustomization(conn *cognitoidentityprovider.CognitoIdentit
 
 	return output.UICustomization, nil
 }
+
+// UserPoolDomainByName returns the user pool domain corresponding to the specified domain name.
+func UserPoolDomainByName(conn *cognitoidentityprovider.CognitoIdentityProvider, domain string) (*cognitoidentityprovider.DomainDescriptionType, error) {
+	input := &cognitoidentityprovider.DescribeUserPoolDomainInput{
+		Domain: aws.String(domain),
+	}
+
+	output, err := conn.DescribeUserPoolDomain(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// DescribeUserPoolDomain returns an empty description rather than an error
+	// for a domain that does not exist.
+	if output == nil || output.DomainDescription == nil || output.DomainDescription.Status == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "Empty result",
+			LastRequest: input,
+		}
+	}
+
+	return output.DomainDescription, nil
+}
+
+// UserPoolByID returns the user pool corresponding to the specified ID.
+func UserPoolByID(conn *cognitoidentityprovider.CognitoIdentityProvider, id string) (*cognitoidentityprovider.UserPoolType, error) {
+	input := &cognitoidentityprovider.DescribeUserPoolInput{
+		UserPoolId: aws.String(id),
+	}
+
+	output, err := conn.DescribeUserPool(input)
+
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.UserPool == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.UserPool, nil
+}
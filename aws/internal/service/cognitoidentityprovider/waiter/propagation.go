@@ -0,0 +1,21 @@
+package waiter
+
+import (
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+)
+
+// smsRolePropagationErrors are returned by Cognito while the SMS IAM role or
+// its policy has not yet propagated.
+var smsRolePropagationErrors = []iamwaiter.PropagationError{
+	// Cognito cannot yet assume the SMS role.
+	{Code: cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, Message: "Role does not have a trust relationship allowing Cognito to assume the role"},
+	// The SMS role policy does not yet allow publishing to SNS.
+	{Code: cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, Message: "Role does not have permission to publish with SNS"},
+}
+
+// IsPropagationError returns whether err is a transient Cognito error caused by
+// an IAM role or policy change that has not yet propagated.
+func IsPropagationError(err error) bool {
+	return iamwaiter.IsPropagationError(err, smsRolePropagationErrors...)
+}
@@ -0,0 +1,47 @@
+package waiter
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+)
+
+func TestIsPropagationError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name: "nil",
+			err:  nil,
+		},
+		{
+			name:     "SMS role trust relationship",
+			err:      awserr.New(cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, "Role does not have a trust relationship allowing Cognito to assume the role", nil),
+			expected: true,
+		},
+		{
+			name:     "SMS role access policy",
+			err:      awserr.New(cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, "Role does not have permission to publish with SNS", nil),
+			expected: true,
+		},
+		{
+			name: "SMS role access policy other message",
+			err:  awserr.New(cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, "Role ARN is malformed", nil),
+		},
+		{
+			name: "other error code",
+			err:  awserr.New(cognitoidentityprovider.ErrCodeInvalidParameterException, "Role does not have permission to publish with SNS", nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := IsPropagationError(testCase.err); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
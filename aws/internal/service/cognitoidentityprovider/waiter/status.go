@@ -4,6 +4,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const (
@@ -31,3 +33,20 @@ func UserPoolDomainStatus(conn *cognitoidentityprovider.CognitoIdentityProvider,
 		return output, aws.StringValue(output.DomainDescription.Status), nil
 	}
 }
+
+// UserPoolStatus fetches the User Pool and its Status
+func UserPoolStatus(conn *cognitoidentityprovider.CognitoIdentityProvider, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := finder.UserPoolByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
@@ -10,6 +10,12 @@ import (
 const (
 	// Maximum amount of time to wait for an Operation to return Success
 	UserPoolDomainDeleteTimeout = 1 * time.Minute
+
+	// Maximum amount of time to wait for a newly created User Pool to be visible
+	UserPoolPropagationTimeout = 2 * time.Minute
+
+	// Maximum amount of time to retry a throttled User Pool describe
+	UserPoolDescribeThrottleTimeout = 2 * time.Minute
 )
 
 // UserPoolDomainDeleted waits for an Operation to return Success
@@ -0,0 +1,70 @@
+package firehose
+
+import (
+	"github.com/aws/aws-sdk-go/service/firehose"
+)
+
+// These should be defined in the AWS SDK for Go.
+// https://docs.aws.amazon.com/firehose/latest/dev/Message_extraction.html
+const (
+	ProcessorTypeCloudWatchLogProcessing = "CloudWatchLogProcessing"
+	ProcessorTypeDecompression           = "Decompression"
+)
+
+func ProcessorType_Values() []string {
+	result := firehose.ProcessorType_Values()
+	result = appendUniqueString(result, ProcessorTypeCloudWatchLogProcessing)
+	result = appendUniqueString(result, ProcessorTypeDecompression)
+	return result
+}
+
+const (
+	ProcessorParameterNameCompressionFormat     = "CompressionFormat"
+	ProcessorParameterNameDataMessageExtraction = "DataMessageExtraction"
+)
+
+func ProcessorParameterName_Values() []string {
+	result := firehose.ProcessorParameterName_Values()
+	result = appendUniqueString(result, ProcessorParameterNameCompressionFormat)
+	result = appendUniqueString(result, ProcessorParameterNameDataMessageExtraction)
+	return result
+}
+
+// ProcessorParameterNamesByType returns the parameter names accepted by
+// processor types that only support a fixed set of parameters.
+func ProcessorParameterNamesByType() map[string][]string {
+	return map[string][]string{
+		ProcessorTypeCloudWatchLogProcessing: {ProcessorParameterNameDataMessageExtraction},
+		ProcessorTypeDecompression:           {ProcessorParameterNameCompressionFormat},
+	}
+}
+
+// Buffering hint limits, which differ by destination.
+// https://docs.aws.amazon.com/firehose/latest/APIReference/API_BufferingHints.html
+// https://docs.aws.amazon.com/firehose/latest/APIReference/API_ElasticsearchBufferingHints.html
+// https://docs.aws.amazon.com/firehose/latest/APIReference/API_HttpEndpointBufferingHints.html
+const (
+	BufferingIntervalInSecondsMin = 60
+	BufferingIntervalInSecondsMax = 900
+
+	BufferingSizeInMBsMin              = 1
+	S3BufferingSizeInMBsMax            = 128
+	ElasticsearchBufferingSizeInMBsMax = 100
+	HttpEndpointBufferingSizeInMBsMax  = 64
+)
+
+func appendUniqueString(slice []string, elem string) []string {
+	for _, e := range slice {
+		if e == elem {
+			return slice
+		}
+	}
+	return append(slice, elem)
+}
+
+// Partition key expressions in S3 prefixes.
+// https://docs.aws.amazon.com/firehose/latest/dev/dynamic-partitioning.html
+const (
+	PrefixExpressionPartitionKeyFromLambda = "partitionKeyFromLambda"
+	PrefixExpressionPartitionKeyFromQuery  = "partitionKeyFromQuery"
+)
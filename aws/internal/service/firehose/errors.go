@@ -0,0 +1,5 @@
+package firehose
+
+const (
+	ErrCodeAccessDeniedException = "AccessDeniedException"
+)
@@ -0,0 +1,34 @@
+package waiter
+
+import (
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+)
+
+// PropagationError is an error code and message pair returned by a service
+// when an IAM role or policy has not yet propagated.
+type PropagationError struct {
+	Code    string
+	Message string
+}
+
+// propagationErrors are the propagation errors returned by more than one service.
+var propagationErrors = []PropagationError{
+	// The service cannot yet assume the execution role.
+	{Code: "ValidationException", Message: "The execution role ARN is invalid."},
+}
+
+// IsPropagationError returns whether err is a transient error caused by an IAM
+// role or policy change that has not yet propagated, e.g. a role that cannot be
+// assumed or that lacks a permission. Service specific errors can be passed as
+// additional. Such errors should be retried for up to PropagationTimeout.
+func IsPropagationError(err error, additional ...PropagationError) bool {
+	for _, errs := range [][]PropagationError{propagationErrors, additional} {
+		for _, v := range errs {
+			if tfawserr.ErrMessageContains(err, v.Code, v.Message) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
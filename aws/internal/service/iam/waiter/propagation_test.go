@@ -0,0 +1,72 @@
+package waiter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestIsPropagationError(t *testing.T) {
+	additional := []PropagationError{
+		{Code: "InvalidRoleException", Message: "Role cannot be assumed"},
+	}
+
+	testCases := []struct {
+		name       string
+		err        error
+		additional []PropagationError
+		expected   bool
+	}{
+		{
+			name: "nil",
+			err:  nil,
+		},
+		{
+			name: "non-AWS error",
+			err:  errors.New("The execution role ARN is invalid."),
+		},
+		{
+			name:     "execution role",
+			err:      awserr.New("ValidationException", "The execution role ARN is invalid.", nil),
+			expected: true,
+		},
+		{
+			name: "other validation error",
+			err:  awserr.New("ValidationException", "Invalid S3Uri provided", nil),
+		},
+		{
+			name: "other error code",
+			err:  awserr.New("AccessDeniedException", "The execution role ARN is invalid.", nil),
+		},
+		{
+			name: "additional without additional errors",
+			err:  awserr.New("InvalidRoleException", "Role cannot be assumed", nil),
+		},
+		{
+			name:       "additional",
+			err:        awserr.New("InvalidRoleException", "Role cannot be assumed", nil),
+			additional: additional,
+			expected:   true,
+		},
+		{
+			name:       "additional other message",
+			err:        awserr.New("InvalidRoleException", "Role ARN is malformed", nil),
+			additional: additional,
+		},
+		{
+			name:       "execution role with additional errors",
+			err:        awserr.New("ValidationException", "The execution role ARN is invalid.", nil),
+			additional: additional,
+			expected:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := IsPropagationError(testCase.err, testCase.additional...); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -4,12 +4,18 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kms/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 const (
 	ARNSeparator = "/"
 	ARNService   = "kms"
+
+	aliasPrefix = "alias/"
 )
 
 // AliasARNToKeyARN converts an alias ARN to a CMK ARN.
@@ -58,3 +64,48 @@ func KeyARNOrIDEqual(arnOrID1, arnOrID2 string) bool {
 
 	return false
 }
+
+// IsAlias returns whether the specified CMK identifier is an alias name or alias ARN.
+func IsAlias(aliasOrKeyID string) bool {
+	if strings.HasPrefix(aliasOrKeyID, aliasPrefix) {
+		return true
+	}
+
+	parsedARN, err := arn.Parse(aliasOrKeyID)
+
+	return err == nil && strings.HasPrefix(parsedARN.Resource, aliasPrefix)
+}
+
+// NormalizeKeyARN returns the configured CMK identifier if it refers to the CMK
+// whose ARN is returned by AWS, otherwise the returned key ARN.
+// Key ARNs and IDs are compared without calling the KMS API. Aliases are only
+// resolved via DescribeKey when resolveAliases is true.
+func NormalizeKeyARN(conn *kms.KMS, configured, keyARN string, resolveAliases bool) (string, error) {
+	if configured == "" || keyARN == "" {
+		return keyARN, nil
+	}
+
+	if KeyARNOrIDEqual(configured, keyARN) {
+		return configured, nil
+	}
+
+	if !resolveAliases || !IsAlias(configured) {
+		return keyARN, nil
+	}
+
+	key, err := finder.KeyByID(conn, configured)
+
+	if tfresource.NotFound(err) {
+		return keyARN, nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("error resolving KMS Alias (%s): %w", configured, err)
+	}
+
+	if aws.StringValue(key.Arn) == keyARN {
+		return configured, nil
+	}
+
+	return keyARN, nil
+}
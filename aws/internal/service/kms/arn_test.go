@@ -4,6 +4,11 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
 	tfkms "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kms"
 )
 
@@ -133,3 +138,179 @@ func TestKeyARNOrIDEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestIsAlias(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{
+			name:  "empty",
+			input: "",
+		},
+		{
+			name:  "key ID",
+			input: "1234abcd-12ab-34cd-56ef-1234567890ab",
+		},
+		{
+			name:  "key ARN",
+			input: "arn:aws:kms:us-east-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+		},
+		{
+			name:  "alias name",
+			input: "alias/test-alias",
+			want:  true,
+		},
+		{
+			name:  "alias ARN",
+			input: "arn:aws:kms:us-east-2:111122223333:alias/test-alias",
+			want:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := tfkms.IsAlias(testCase.input)
+
+			if got != testCase.want {
+				t.Errorf("unexpected IsAlias: %t", got)
+			}
+		})
+	}
+}
+
+func TestNormalizeKeyARN(t *testing.T) {
+	keyARN := "arn:aws:kms:us-east-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+	testCases := []struct {
+		name           string
+		configured     string
+		resolveAliases bool
+		resolvedARN    string
+		err            error
+		want           string
+		wantAPICall    bool
+		wantErr        bool
+	}{
+		{
+			name: "not configured",
+			want: keyARN,
+		},
+		{
+			name:       "key ARN",
+			configured: keyARN,
+			want:       keyARN,
+		},
+		{
+			name:       "key ID",
+			configured: "1234abcd-12ab-34cd-56ef-1234567890ab",
+			want:       "1234abcd-12ab-34cd-56ef-1234567890ab",
+		},
+		{
+			name:       "other key ARN",
+			configured: "arn:aws:kms:us-east-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ac",
+			want:       keyARN,
+		},
+		{
+			name:           "key ID resolving aliases",
+			configured:     "1234abcd-12ab-34cd-56ef-1234567890ab",
+			resolveAliases: true,
+			want:           "1234abcd-12ab-34cd-56ef-1234567890ab",
+		},
+		{
+			name:        "alias name not resolving aliases",
+			configured:  "alias/test-alias",
+			resolvedARN: keyARN,
+			want:        keyARN,
+		},
+		{
+			name:           "alias name",
+			configured:     "alias/test-alias",
+			resolveAliases: true,
+			resolvedARN:    keyARN,
+			want:           "alias/test-alias",
+			wantAPICall:    true,
+		},
+		{
+			name:           "alias ARN",
+			configured:     "arn:aws:kms:us-east-2:111122223333:alias/test-alias",
+			resolveAliases: true,
+			resolvedARN:    keyARN,
+			want:           "arn:aws:kms:us-east-2:111122223333:alias/test-alias",
+			wantAPICall:    true,
+		},
+		{
+			name:           "alias for other key",
+			configured:     "alias/test-alias",
+			resolveAliases: true,
+			resolvedARN:    "arn:aws:kms:us-east-2:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ac",
+			want:           keyARN,
+			wantAPICall:    true,
+		},
+		{
+			name:           "alias not found",
+			configured:     "alias/test-alias",
+			resolveAliases: true,
+			err:            awserr.New(kms.ErrCodeNotFoundException, "Alias not found", nil),
+			want:           keyARN,
+			wantAPICall:    true,
+		},
+		{
+			name:           "error",
+			configured:     "alias/test-alias",
+			resolveAliases: true,
+			err:            awserr.New("AccessDeniedException", "not authorized", nil),
+			wantAPICall:    true,
+			wantErr:        true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := kms.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var apiCalled bool
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				apiCalled = true
+
+				if testCase.err != nil {
+					r.Error = testCase.err
+					return
+				}
+
+				data := r.Data.(*kms.DescribeKeyOutput)
+				data.KeyMetadata = &kms.KeyMetadata{
+					Arn:      aws.String(testCase.resolvedARN),
+					KeyState: aws.String(kms.KeyStateEnabled),
+				}
+			})
+
+			got, err := tfkms.NormalizeKeyARN(conn, testCase.configured, keyARN, testCase.resolveAliases)
+
+			if testCase.wantErr && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if apiCalled != testCase.wantAPICall {
+				t.Errorf("expected API call to be %t, got %t", testCase.wantAPICall, apiCalled)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, expected %s", got, testCase.want)
+			}
+		})
+	}
+}
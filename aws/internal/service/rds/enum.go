@@ -1,5 +1,9 @@
 package rds
 
+import (
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
 const (
 	DBClusterRoleStatusActive  = "ACTIVE"
 	DBClusterRoleStatusDeleted = "DELETED"
@@ -12,3 +16,23 @@ const (
 	EventSubscriptionStatusDeleting  = "deleting"
 	EventSubscriptionStatusModifying = "modifying"
 )
+
+// These should be defined in the AWS SDK for Go.
+const (
+	EngineFamilySQLServer = "SQLSERVER"
+)
+
+func EngineFamily_Values() []string {
+	result := rds.EngineFamily_Values()
+	result = appendUniqueString(result, EngineFamilySQLServer)
+	return result
+}
+
+func appendUniqueString(slice []string, elem string) []string {
+	for _, e := range slice {
+		if e == elem {
+			return slice
+		}
+	}
+	return append(slice, elem)
+}
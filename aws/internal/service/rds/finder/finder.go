@@ -140,6 +140,49 @@ func DBProxyByName(conn *rds.RDS, name string) (*rds.DBProxy, error) {
 	return output.DBProxies[0], nil
 }
 
+// DBProxyDefaultTargetGroupByDBProxyName returns the default target group of the specified DB Proxy.
+func DBProxyDefaultTargetGroupByDBProxyName(conn *rds.RDS, dbProxyName string) (*rds.DBProxyTargetGroup, error) {
+	input := &rds.DescribeDBProxyTargetGroupsInput{
+		DBProxyName: aws.String(dbProxyName),
+	}
+	var defaultTargetGroup *rds.DBProxyTargetGroup
+
+	err := conn.DescribeDBProxyTargetGroupsPages(input, func(page *rds.DescribeDBProxyTargetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, targetGroup := range page.TargetGroups {
+			if aws.BoolValue(targetGroup.IsDefault) {
+				defaultTargetGroup = targetGroup
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBProxyNotFoundFault) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if defaultTargetGroup == nil {
+		return nil, &resource.NotFoundError{
+			Message:     "default target group not found",
+			LastRequest: input,
+		}
+	}
+
+	return defaultTargetGroup, nil
+}
+
 func EventSubscriptionByID(conn *rds.RDS, id string) (*rds.EventSubscription, error) {
 	input := &rds.DescribeEventSubscriptionsInput{
 		SubscriptionName: aws.String(id),
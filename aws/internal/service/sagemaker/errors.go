@@ -1,5 +1,7 @@
 package sagemaker
 
 const (
-	ErrCodeValidationException = "ValidationException"
+	ErrCodeAccessDeniedException = "AccessDeniedException"
+	ErrCodeThrottlingException   = "ThrottlingException"
+	ErrCodeValidationException   = "ValidationException"
 )
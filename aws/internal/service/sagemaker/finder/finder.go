@@ -1,6 +1,8 @@
 package finder
 
 import (
+	"sort"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
@@ -374,3 +376,81 @@ func StudioLifecycleConfigByName(conn *sagemaker.SageMaker, name string) (*sagem
 
 	return output, nil
 }
+
+func ModelByName(conn *sagemaker.SageMaker, name string) (*sagemaker.DescribeModelOutput, error) {
+	input := &sagemaker.DescribeModelInput{
+		ModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeModel(input)
+
+	if tfawserr.ErrMessageContains(err, tfsagemaker.ErrCodeValidationException, "Could not find model") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// EndpointsUsingConfig returns the names of the endpoints that reference the specified endpoint configuration.
+// Returns an empty list if no endpoint references the configuration.
+// Requires the sagemaker:ListEndpoints and sagemaker:DescribeEndpoint permissions; API errors,
+// including access denied errors, are returned unmodified so callers can decide how to handle them.
+func EndpointsUsingConfig(conn *sagemaker.SageMaker, endpointConfigName string) ([]string, error) {
+	var names []string
+	var describeErr error
+
+	err := conn.ListEndpointsPages(&sagemaker.ListEndpointsInput{}, func(page *sagemaker.ListEndpointsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, summary := range page.Endpoints {
+			if summary == nil {
+				continue
+			}
+
+			output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+				EndpointName: summary.EndpointName,
+			})
+
+			// The endpoint may have been deleted since it was listed.
+			if tfawserr.ErrMessageContains(err, tfsagemaker.ErrCodeValidationException, "Could not find endpoint") {
+				continue
+			}
+
+			if err != nil {
+				describeErr = err
+				return false
+			}
+
+			if aws.StringValue(output.EndpointConfigName) == endpointConfigName {
+				names = append(names, aws.StringValue(output.EndpointName))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeErr != nil {
+		return nil, describeErr
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
@@ -0,0 +1,144 @@
+package finder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	tfsagemaker "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
+)
+
+func TestEndpointConfigByName(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		wantNotFound bool
+		wantErr      bool
+	}{
+		{
+			name: "found",
+		},
+		{
+			name:         "not found",
+			err:          awserr.New(tfsagemaker.ErrCodeValidationException, "Could not find endpoint configuration \"test\".", nil),
+			wantNotFound: true,
+			wantErr:      true,
+		},
+		{
+			name:    "other validation error",
+			err:     awserr.New(tfsagemaker.ErrCodeValidationException, "1 validation error detected", nil),
+			wantErr: true,
+		},
+	}
+
+	conn := testSagemakerConn(t)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if testCase.err != nil {
+					r.Error = testCase.err
+					return
+				}
+
+				data := r.Data.(*sagemaker.DescribeEndpointConfigOutput)
+				data.EndpointConfigName = aws.String("test")
+			})
+
+			output, err := EndpointConfigByName(conn, "test")
+
+			testFinderVerifyError(t, err, testCase.wantNotFound, testCase.wantErr)
+
+			if !testCase.wantErr && aws.StringValue(output.EndpointConfigName) != "test" {
+				t.Errorf("unexpected output: %s", output)
+			}
+		})
+	}
+}
+
+func TestModelByName(t *testing.T) {
+	testCases := []struct {
+		name         string
+		err          error
+		wantNotFound bool
+		wantErr      bool
+	}{
+		{
+			name: "found",
+		},
+		{
+			name:         "not found",
+			err:          awserr.New(tfsagemaker.ErrCodeValidationException, "Could not find model \"arn:aws:sagemaker:us-west-2:123456789012:model/test\".", nil),
+			wantNotFound: true,
+			wantErr:      true,
+		},
+		{
+			name:    "other validation error",
+			err:     awserr.New(tfsagemaker.ErrCodeValidationException, "1 validation error detected", nil),
+			wantErr: true,
+		},
+	}
+
+	conn := testSagemakerConn(t)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if testCase.err != nil {
+					r.Error = testCase.err
+					return
+				}
+
+				data := r.Data.(*sagemaker.DescribeModelOutput)
+				data.ModelName = aws.String("test")
+			})
+
+			output, err := ModelByName(conn, "test")
+
+			testFinderVerifyError(t, err, testCase.wantNotFound, testCase.wantErr)
+
+			if !testCase.wantErr && aws.StringValue(output.ModelName) != "test" {
+				t.Errorf("unexpected output: %s", output)
+			}
+		})
+	}
+}
+
+func testSagemakerConn(t *testing.T) *sagemaker.SageMaker {
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return sagemaker.New(sess)
+}
+
+func testFinderVerifyError(t *testing.T, err error, wantNotFound, wantErr bool) {
+	t.Helper()
+
+	if wantErr && err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	if !wantErr && err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := tfresource.NotFound(err); got != wantNotFound {
+		t.Errorf("expected NotFound to be %t, got %t", wantNotFound, got)
+	}
+
+	var nfe *resource.NotFoundError
+	if got := errors.As(err, &nfe); got != wantNotFound {
+		t.Errorf("expected *resource.NotFoundError to be %t, got %t", wantNotFound, got)
+	}
+}
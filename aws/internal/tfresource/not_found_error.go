@@ -79,6 +79,26 @@ func (e *TooManyResultsError) As(target interface{}) bool {
 	return true
 }
 
+// SingleResult returns nil if count, the number of results found for lastRequest, is exactly one.
+// Otherwise it returns an EmptyResultError or a TooManyResultsError, which satisfy NotFound and
+// can be passed to SingularDataSourceFindError. Callers index their own typed results, e.g.
+//
+//	if err := tfresource.SingleResult(len(matches), input); err != nil {
+//		return tfresource.SingularDataSourceFindError("Example Resource", err)
+//	}
+//
+//	match := matches[0]
+func SingleResult(count int, lastRequest interface{}) error {
+	switch {
+	case count == 0:
+		return NewEmptyResultError(lastRequest)
+	case count > 1:
+		return NewTooManyResultsError(count, lastRequest)
+	default:
+		return nil
+	}
+}
+
 // SingularDataSourceFindError returns a standard error message for a singular data source's non-nil resource find error.
 func SingularDataSourceFindError(resourceType string, err error) error {
 	if NotFound(err) {
@@ -154,3 +154,74 @@ func TestTooManyResultsErrorIs(t *testing.T) {
 		})
 	}
 }
+
+func TestSingleResult(t *testing.T) {
+	testCases := []struct {
+		name            string
+		count           int
+		expectedErr     error
+		expectedMessage string
+	}{
+		{
+			name:            "no results",
+			count:           0,
+			expectedErr:     ErrEmptyResult,
+			expectedMessage: "no matching Example Resource found",
+		},
+		{
+			name:  "single result",
+			count: 1,
+		},
+		{
+			name:            "multiple results",
+			count:           3,
+			expectedErr:     ErrTooManyResults,
+			expectedMessage: "multiple Example Resources matched; use additional constraints to reduce matches to a single Example Resource",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			lastRequest := 123
+			err := SingleResult(testCase.count, lastRequest)
+
+			if testCase.expectedErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, testCase.expectedErr) {
+				t.Fatalf("expected %T, got: %v", testCase.expectedErr, err)
+			}
+
+			if !NotFound(err) {
+				t.Errorf("expected NotFound to return true for %v", err)
+			}
+
+			var nfe *resource.NotFoundError
+			if errors.As(err, &nfe) && nfe.LastRequest != lastRequest {
+				t.Errorf("unexpected value for LastRequest")
+			}
+
+			if got := SingularDataSourceFindError("Example Resource", err).Error(); got != testCase.expectedMessage {
+				t.Errorf("expected %q, got %q", testCase.expectedMessage, got)
+			}
+		})
+	}
+}
+
+func TestSingleResultTooManyResultsCount(t *testing.T) {
+	err := SingleResult(2, nil)
+
+	var tmre *TooManyResultsError
+	if !errors.As(err, &tmre) {
+		t.Fatalf("expected TooManyResultsError, got: %v", err)
+	}
+
+	if tmre.Count != 2 {
+		t.Errorf("expected Count to be 2, got %d", tmre.Count)
+	}
+}
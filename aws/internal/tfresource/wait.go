@@ -62,3 +62,33 @@ func WaitUntilContext(ctx context.Context, timeout time.Duration, f func() (bool
 func WaitUntil(timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	return WaitUntilContext(context.Background(), timeout, f, opts)
 }
+
+// WaitUntilExists waits for the StateRefreshFunc `refresh` to return a non-nil object.
+// A "not found" error from `refresh` is treated the same as a nil object.
+// If `refresh` returns any other error, return immediately with that error.
+// If `timeout` is exceeded before an object is returned, return an error.
+func WaitUntilExists(ctx context.Context, refresh resource.StateRefreshFunc, timeout time.Duration) (interface{}, error) {
+	var output interface{}
+
+	err := WaitUntilContext(ctx, timeout, func() (bool, error) {
+		v, _, err := refresh()
+
+		if NotFound(err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		output = v
+
+		return v != nil, nil
+	}, WaitOpts{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -1,11 +1,13 @@
 package tfresource_test
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
@@ -63,3 +65,76 @@ func TestWaitUntil(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitUntilExists(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name           string
+		Refresh        resource.StateRefreshFunc
+		ExpectedOutput interface{}
+		ExpectError    bool
+	}{
+		{
+			Name: "exists",
+			Refresh: func() (interface{}, string, error) {
+				return "found", "", nil
+			},
+			ExpectedOutput: "found",
+		},
+		{
+			Name: "immediate error",
+			Refresh: func() (interface{}, string, error) {
+				return nil, "", errors.New("TestCode")
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "never exists",
+			Refresh: func() (interface{}, string, error) {
+				return nil, "", nil
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "not found then exists",
+			Refresh: func() (interface{}, string, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, "", &resource.NotFoundError{}
+				}
+
+				return "found", "", nil
+			},
+			ExpectedOutput: "found",
+		},
+		{
+			Name: "nil then exists",
+			Refresh: func() (interface{}, string, error) {
+				if atomic.CompareAndSwapInt32(&retryCount, 0, 1) {
+					return nil, "", nil
+				}
+
+				return "found", "", nil
+			},
+			ExpectedOutput: "found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			output, err := tfresource.WaitUntilExists(context.Background(), testCase.Refresh, 5*time.Second)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if output != testCase.ExpectedOutput {
+				t.Errorf("got output %v, expected %v", output, testCase.ExpectedOutput)
+			}
+		})
+	}
+}
@@ -181,12 +181,31 @@ func Provider() *schema.Provider {
 				Description: descriptions["skip_metadata_api_check"],
 			},
 
+			"skip_tag_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["skip_tag_on_create"],
+			},
+
 			"s3_force_path_style": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+			"validate_sagemaker_model_names": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["validate_sagemaker_model_names"],
+			},
+			"validate_sagemaker_s3_output_buckets": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["validate_sagemaker_s3_output_buckets"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -204,6 +223,7 @@ func Provider() *schema.Provider {
 			"aws_apigatewayv2_apis":                          dataSourceAwsApiGatewayV2Apis(),
 			"aws_appmesh_mesh":                               dataSourceAwsAppmeshMesh(),
 			"aws_appmesh_virtual_service":                    dataSourceAwsAppmeshVirtualService(),
+			"aws_appstream_fleet":                            dataSourceAwsAppStreamFleet(),
 			"aws_arn":                                        dataSourceAwsArn(),
 			"aws_autoscaling_group":                          dataSourceAwsAutoscalingGroup(),
 			"aws_autoscaling_groups":                         dataSourceAwsAutoscalingGroups(),
@@ -234,6 +254,7 @@ func Provider() *schema.Provider {
 			"aws_cloudwatch_log_groups":                      dataSourceAwsCloudwatchLogGroups(),
 			"aws_codeartifact_authorization_token":           dataSourceAwsCodeArtifactAuthorizationToken(),
 			"aws_codeartifact_repository_endpoint":           dataSourceAwsCodeArtifactRepositoryEndpoint(),
+			"aws_cognito_user_pool":                          dataSourceAwsCognitoUserPool(),
 			"aws_cognito_user_pools":                         dataSourceAwsCognitoUserPools(),
 			"aws_codecommit_repository":                      dataSourceAwsCodeCommitRepository(),
 			"aws_codestarconnections_connection":             dataSourceAwsCodeStarConnectionsConnection(),
@@ -245,6 +266,7 @@ func Provider() *schema.Provider {
 			"aws_db_event_categories":                        dataSourceAwsDbEventCategories(),
 			"aws_db_instance":                                dataSourceAwsDbInstance(),
 			"aws_db_proxy":                                   dataSourceAwsDbProxy(),
+			"aws_db_proxy_default_target_group":              dataSourceAwsDbProxyDefaultTargetGroup(),
 			"aws_db_snapshot":                                dataSourceAwsDbSnapshot(),
 			"aws_db_subnet_group":                            dataSourceAwsDbSubnetGroup(),
 			"aws_directory_service_directory":                dataSourceAwsDirectoryServiceDirectory(),
@@ -343,6 +365,7 @@ func Provider() *schema.Provider {
 			"aws_iot_endpoint":                               dataSourceAwsIotEndpoint(),
 			"aws_ip_ranges":                                  dataSourceAwsIPRanges(),
 			"aws_kinesis_firehose_delivery_stream":           dataSourceAwsKinesisFirehoseDeliveryStream(),
+			"aws_kinesis_firehose_delivery_streams":          dataSourceAwsKinesisFirehoseDeliveryStreams(),
 			"aws_kinesis_stream":                             dataSourceAwsKinesisStream(),
 			"aws_kinesis_stream_consumer":                    dataSourceAwsKinesisStreamConsumer(),
 			"aws_kms_alias":                                  dataSourceAwsKmsAlias(),
@@ -412,6 +435,8 @@ func Provider() *schema.Provider {
 			"aws_s3_bucket":                                  dataSourceAwsS3Bucket(),
 			"aws_s3_bucket_object":                           dataSourceAwsS3BucketObject(),
 			"aws_s3_bucket_objects":                          dataSourceAwsS3BucketObjects(),
+			"aws_sagemaker_endpoint_configurations":          dataSourceAwsSagemakerEndpointConfigurations(),
+			"aws_sagemaker_model":                            dataSourceAwsSagemakerModel(),
 			"aws_sagemaker_prebuilt_ecr_image":               dataSourceAwsSageMakerPrebuiltECRImage(),
 			"aws_secretsmanager_secret":                      dataSourceAwsSecretsManagerSecret(),
 			"aws_secretsmanager_secret_rotation":             dataSourceAwsSecretsManagerSecretRotation(),
@@ -1362,10 +1387,22 @@ func init() {
 		"skip_medatadata_api_check": "Skip the AWS Metadata API check. " +
 			"Used for AWS API implementations that do not have a metadata api endpoint.",
 
+		"skip_tag_on_create": "Set this to true to omit tags from resource create requests\n" +
+			"and apply them with a separate tagging call after creation.\n" +
+			"Only honored by resources that support tagging on create.",
+
 		"s3_force_path_style": "Set this to true to force the request to use path-style addressing,\n" +
 			"i.e., http://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\n" +
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
+
+		"validate_sagemaker_model_names": "Set this to true to verify during plan that the models\n" +
+			"referenced by SageMaker endpoint configuration production variants exist.\n" +
+			"Requires sagemaker:DescribeModel permissions.",
+
+		"validate_sagemaker_s3_output_buckets": "Set this to true to verify before creating a SageMaker endpoint\n" +
+			"configuration that the async inference S3 output bucket exists.\n" +
+			"Requires s3:ListBucket permissions.",
 	}
 
 	endpointServiceNames = []string{
@@ -1553,8 +1590,12 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId: d.Get("skip_requesting_account_id").(bool),
 		SkipMetadataApiCheck:    d.Get("skip_metadata_api_check").(bool),
+		SkipTagOnCreate:         d.Get("skip_tag_on_create").(bool),
 		S3ForcePathStyle:        d.Get("s3_force_path_style").(bool),
 		terraformVersion:        terraformVersion,
+
+		ValidateSagemakerModelNames:      d.Get("validate_sagemaker_model_names").(bool),
+		ValidateSagemakerS3OutputBuckets: d.Get("validate_sagemaker_s3_output_buckets").(bool),
 	}
 
 	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
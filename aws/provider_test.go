@@ -991,6 +991,15 @@ provider "aws" {
 `, key1)
 }
 
+func testAccProviderConfigSkipTagOnCreate() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  skip_tag_on_create = true
+}
+`
+}
+
 // testAccNamedRegionalProviderConfig creates a new provider named configuration with a region.
 //
 // This can be used to build multiple provider configuration testing.
@@ -542,11 +542,11 @@ func expandDomainJoinInfo(tfList []interface{}) *appstream.DomainJoinInfo {
 	apiObject := &appstream.DomainJoinInfo{}
 
 	tfMap := tfList[0].(map[string]interface{})
-	if v, ok := tfMap["directory_name"]; ok {
-		apiObject.DirectoryName = aws.String(v.(string))
+	if v, ok := tfMap["directory_name"].(string); ok && v != "" {
+		apiObject.DirectoryName = aws.String(v)
 	}
-	if v, ok := tfMap["organizational_unit_distinguished_name"]; ok {
-		apiObject.OrganizationalUnitDistinguishedName = aws.String(v.(string))
+	if v, ok := tfMap["organizational_unit_distinguished_name"].(string); ok && v != "" {
+		apiObject.OrganizationalUnitDistinguishedName = aws.String(v)
 	}
 
 	return apiObject
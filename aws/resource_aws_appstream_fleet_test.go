@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -86,6 +87,59 @@ func testAccErrorCheckSkipAppStream(t *testing.T) resource.ErrorCheckFunc {
 	)
 }
 
+func TestExpandDomainJoinInfo(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *appstream.DomainJoinInfo
+	}{
+		{
+			Name:     "empty",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name: "directory only",
+			Input: []interface{}{map[string]interface{}{
+				"directory_name":                         "corp.example.com",
+				"organizational_unit_distinguished_name": "",
+			}},
+			Expected: &appstream.DomainJoinInfo{
+				DirectoryName: aws.String("corp.example.com"),
+			},
+		},
+		{
+			Name: "both",
+			Input: []interface{}{map[string]interface{}{
+				"directory_name":                         "corp.example.com",
+				"organizational_unit_distinguished_name": "OU=AppStream,DC=corp,DC=example,DC=com",
+			}},
+			Expected: &appstream.DomainJoinInfo{
+				DirectoryName:                       aws.String("corp.example.com"),
+				OrganizationalUnitDistinguishedName: aws.String("OU=AppStream,DC=corp,DC=example,DC=com"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := expandDomainJoinInfo(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+
+			if got == nil {
+				return
+			}
+
+			if flattened := flattenDomainInfo(got); !reflect.DeepEqual(flattened, testCase.Input) {
+				t.Errorf("flatten got %v, expected %v", flattened, testCase.Input)
+			}
+		})
+	}
+}
+
 func TestAccAwsAppStreamFleet_basic(t *testing.T) {
 	var fleetOutput appstream.Fleet
 	resourceName := "aws_appstream_fleet.test"
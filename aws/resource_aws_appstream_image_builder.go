@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/waiter"
 )
@@ -54,6 +55,10 @@ func resourceAwsAppStreamImageBuilder() *schema.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 100),
+				// AWS resolves LATEST to a specific agent version.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return new == tfappstream.AgentVersionLatest && old != ""
+				},
 			},
 			"arn": {
 				Type:     schema.TypeString,
@@ -86,12 +91,14 @@ func resourceAwsAppStreamImageBuilder() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"directory_name": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"domain_join_info.0.organizational_unit_distinguished_name"},
 						},
 						"organizational_unit_distinguished_name": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							RequiredWith: []string{"domain_join_info.0.directory_name"},
 						},
 					},
 				},
@@ -275,7 +282,7 @@ func resourceAwsAppStreamImageBuilderRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream ImageBuilder (%s): %w", "domain_join_info", d.Id(), err))
 	}
 
-	if err = d.Set("vpc_config", flattenVpcConfig(imageBuilder.VpcConfig)); err != nil {
+	if err = d.Set("vpc_config", flattenAppStreamImageBuilderVpcConfig(imageBuilder.VpcConfig)); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting `%s` for AppStream ImageBuilder (%s): %w", "vpc_config", d.Id(), err))
 	}
 
@@ -362,3 +369,16 @@ func expandAppStreamImageBuilderVpcConfig(tfList []interface{}) *appstream.VpcCo
 
 	return apiObject
 }
+
+func flattenAppStreamImageBuilderVpcConfig(apiObject *appstream.VpcConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"security_group_ids": flattenStringSet(apiObject.SecurityGroupIds),
+		"subnet_ids":         flattenStringSet(apiObject.SubnetIds),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appstream"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfappstream "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/appstream/lister"
 )
@@ -39,6 +42,7 @@ func testSweepAppStreamImageBuilder(region string) error {
 	var errs *multierror.Error
 
 	input := &appstream.DescribeImageBuildersInput{}
+	minAge := testSweepMinAge()
 
 	err = lister.DescribeImageBuildersPagesWithContext(context.TODO(), conn, input, func(page *appstream.DescribeImageBuildersOutput, lastPage bool) bool {
 		if page == nil {
@@ -51,12 +55,27 @@ func testSweepAppStreamImageBuilder(region string) error {
 			}
 
 			id := aws.StringValue(imageBuilder.Name)
+			age := time.Since(aws.TimeValue(imageBuilder.CreatedTime)).Round(time.Second)
+
+			// Avoid deleting image builders still in use by concurrent test runs.
+			if minAge > 0 && imageBuilder.CreatedTime != nil && age < minAge {
+				log.Printf("[INFO] Skipping AppStream Image Builder (%s): age %s is less than %s", id, age, minAge)
+				testSweepLogResult(testSweepLogEntry{
+					ID:           id,
+					Outcome:      sweepOutcomeSkipped,
+					Region:       region,
+					ResourceType: "aws_appstream_image_builder",
+				})
+				continue
+			}
+
+			log.Printf("[INFO] Sweeping AppStream Image Builder (%s), age %s", id, age)
 
 			r := resourceAwsAppStreamImageBuilder()
 			d := r.Data(nil)
 			d.SetId(id)
 
-			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client))
+			sweepResources = append(sweepResources, NewTestSweepResource(r, d, client).WithTypeName("aws_appstream_image_builder"))
 		}
 
 		return !lastPage
@@ -72,6 +91,12 @@ func testSweepAppStreamImageBuilder(region string) error {
 
 	if testSweepSkipSweepError(err) {
 		log.Printf("[WARN] Skipping AppStream Image Builders sweep for %s: %s", region, err)
+		testSweepLogResult(testSweepLogEntry{
+			Error:        err.Error(),
+			Outcome:      sweepOutcomeSkipped,
+			Region:       region,
+			ResourceType: "aws_appstream_image_builder",
+		})
 		return nil // In case we have completed some pages, but had errors
 	}
 
@@ -94,6 +119,7 @@ func TestAccAwsAppStreamImageBuilder_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckAwsAppStreamImageBuilderExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "appstream_agent_version"),
 					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
 					resource.TestCheckResourceAttr(resourceName, "state", appstream.ImageBuilderStateRunning),
 				),
@@ -154,6 +180,9 @@ func TestAccAwsAppStreamImageBuilder_complete(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "instance_type", instanceType),
 					resource.TestCheckResourceAttr(resourceName, "description", description),
 					testAccCheckResourceAttrRfc3339(resourceName, "created_time"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "vpc_config.0.subnet_ids.*", "aws_subnet.test", "id"),
 				),
 			},
 			{
@@ -183,6 +212,94 @@ func TestAccAwsAppStreamImageBuilder_complete(t *testing.T) {
 	})
 }
 
+func TestAccAwsAppStreamImageBuilder_VpcConfig(t *testing.T) {
+	resourceName := "aws_appstream_image_builder.test"
+	instanceType := "stream.standard.small"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsAppStreamImageBuilderDestroy,
+		ErrorCheck:        testAccErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAppStreamImageBuilderConfigVpcConfig(instanceType, rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppStreamImageBuilderExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.subnet_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "vpc_config.0.subnet_ids.*", "aws_subnet.test.0", "id"),
+					resource.TestCheckResourceAttr(resourceName, "vpc_config.0.security_group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "vpc_config.0.security_group_ids.*", "aws_security_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_name"},
+			},
+			{
+				// Networking differing from the live builder must force replacement.
+				Config:             testAccAwsAppStreamImageBuilderConfigVpcConfig(instanceType, rName, 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAwsAppStreamImageBuilder_AppStreamAgentVersion(t *testing.T) {
+	resourceName := "aws_appstream_image_builder.test"
+	instanceType := "stream.standard.small"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsAppStreamImageBuilderDestroy,
+		ErrorCheck:        testAccErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsAppStreamImageBuilderConfigAppStreamAgentVersion(instanceType, rName, tfappstream.AgentVersionLatest),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsAppStreamImageBuilderExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "appstream_agent_version"),
+				),
+			},
+			{
+				Config:   testAccAwsAppStreamImageBuilderConfigAppStreamAgentVersion(instanceType, rName, tfappstream.AgentVersionLatest),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_name"},
+			},
+		},
+	})
+}
+
+func TestAccAwsAppStreamImageBuilder_DomainJoinInfo_Invalid(t *testing.T) {
+	instanceType := "stream.standard.small"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAwsAppStreamImageBuilderDestroy,
+		ErrorCheck:        testAccErrorCheck(t, appstream.EndpointsID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAwsAppStreamImageBuilderConfigDomainJoinInfoDirectoryNameOnly(instanceType, rName),
+				ExpectError: regexp.MustCompile(`all of .domain_join_info.0.directory_name,domain_join_info.0.organizational_unit_distinguished_name. must be specified`),
+			},
+		},
+	})
+}
+
 func TestAccAwsAppStreamImageBuilder_Tags(t *testing.T) {
 	resourceName := "aws_appstream_image_builder.test"
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -288,6 +405,31 @@ resource "aws_appstream_image_builder" "test" {
 `, instanceType, name)
 }
 
+func testAccAwsAppStreamImageBuilderConfigAppStreamAgentVersion(instanceType, name, agentVersion string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  appstream_agent_version = %[3]q
+  image_name              = "AppStream-WinServer2012R2-07-19-2021"
+  instance_type           = %[1]q
+  name                    = %[2]q
+}
+`, instanceType, name, agentVersion)
+}
+
+func testAccAwsAppStreamImageBuilderConfigDomainJoinInfoDirectoryNameOnly(instanceType, name string) string {
+	return fmt.Sprintf(`
+resource "aws_appstream_image_builder" "test" {
+  image_name    = "AppStream-WinServer2012R2-07-19-2021"
+  instance_type = %[1]q
+  name          = %[2]q
+
+  domain_join_info {
+    directory_name = "corp.example.com"
+  }
+}
+`, instanceType, name)
+}
+
 func testAccAwsAppStreamImageBuilderConfigComplete(name, description, instanceType string) string {
 	return composeConfig(
 		testAccAvailableAZsNoOptInConfig(),
@@ -315,6 +457,40 @@ resource "aws_appstream_image_builder" "test" {
 `, name, description, instanceType))
 }
 
+func testAccAwsAppStreamImageBuilderConfigVpcConfig(instanceType, name string, subnetIndex int) string {
+	return composeConfig(
+		testAccAvailableAZsNoOptInConfig(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, count.index)
+  vpc_id            = aws_vpc.test.id
+}
+
+resource "aws_security_group" "test" {
+  name   = %[2]q
+  vpc_id = aws_vpc.test.id
+}
+
+resource "aws_appstream_image_builder" "test" {
+  image_name    = "AppStream-WinServer2012R2-07-19-2021"
+  instance_type = %[1]q
+  name          = %[2]q
+
+  vpc_config {
+    security_group_ids = [aws_security_group.test.id]
+    subnet_ids         = [aws_subnet.test[%[3]d].id]
+  }
+}
+`, instanceType, name, subnetIndex))
+}
+
 func testAccAwsAppStreamImageBuilderConfigTags1(instanceType, name, key, value string) string {
 	return fmt.Sprintf(`
 resource "aws_appstream_image_builder" "test" {
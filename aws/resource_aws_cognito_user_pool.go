@@ -1,21 +1,32 @@
 package aws
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/url"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/finder"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/cognitoidentityprovider/waiter"
 	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
+	tfkms "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kms"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
 
 func resourceAwsCognitoUserPool() *schema.Resource {
@@ -94,6 +105,16 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 								},
 							},
 						},
+						"unused_account_validity_days": {
+							Type:       schema.TypeInt,
+							Optional:   true,
+							Computed:   true,
+							Deprecated: "Use password_policy temporary_password_validity_days instead. This value is only read from the user pool and is removed by Cognito on the next update.",
+							// The value is never sent to the API, so configuring it must not produce a diff.
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return true
+							},
+						},
 					},
 				},
 			},
@@ -115,6 +136,14 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"custom_domain_certificate_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"custom_domain_cloudfront_distribution": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"domain": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -329,12 +358,17 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
-				ValidateFunc: validation.Any(
+				ValidateFunc: validation.All(
 					validation.StringLenBetween(1, 128),
 					validation.StringMatch(regexp.MustCompile(`[\w\s+=,.@-]+`),
 						`must satisfy regular expression pattern: [\w\s+=,.@-]+`),
 				),
 			},
+			"resolve_kms_key_alias": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"password_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -515,9 +549,9 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"advanced_security_mode": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringInSlice(cognitoidentityprovider.AdvancedSecurityModeType_Values(), false),
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: resourceAwsCognitoUserPoolValidateAdvancedSecurityMode,
 						},
 					},
 				},
@@ -573,8 +607,131 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceAwsCognitoUserPoolLambdaConfigCustomizeDiff,
+			resourceAwsCognitoUserPoolAccountRecoverySettingCustomizeDiff,
+		),
+	}
+}
+
+// resourceAwsCognitoUserPoolLambdaConfigCustomizeDiff warns when a KMS key is configured
+// without a custom sender trigger, as Cognito ignores the key in that case. The key is
+// still accepted, so unusual but valid configurations keep planning successfully.
+func resourceAwsCognitoUserPoolLambdaConfigCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("lambda_config") {
+		return nil
+	}
+
+	tfList, ok := diff.Get("lambda_config").([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	if msg := cognitoUserPoolLambdaConfigKmsKeyWarning(tfList[0].(map[string]interface{})); msg != "" {
+		log.Printf("[WARN] Cognito User Pool (%s): %s", diff.Id(), msg)
+	}
+
+	return nil
+}
+
+// cognitoUserPoolLambdaConfigKmsKeyWarning returns a message when lambda_config sets
+// kms_key_id without custom_email_sender or custom_sms_sender.
+func cognitoUserPoolLambdaConfigKmsKeyWarning(tfMap map[string]interface{}) string {
+	if v, ok := tfMap["kms_key_id"].(string); !ok || v == "" {
+		return ""
+	}
+
+	if v, ok := tfMap["custom_email_sender"].([]interface{}); ok && len(v) > 0 {
+		return ""
+	}
+
+	if v, ok := tfMap["custom_sms_sender"].([]interface{}); ok && len(v) > 0 {
+		return ""
+	}
+
+	return "lambda_config.0.kms_key_id is set without custom_email_sender or custom_sms_sender and will be ignored by Cognito"
+}
+
+// resourceAwsCognitoUserPoolValidateAdvancedSecurityMode validates the mode and returns
+// a warning diagnostic for OFF, since advanced security is a pool-wide setting and
+// client-level options cannot re-enable threat protection.
+func resourceAwsCognitoUserPoolValidateAdvancedSecurityMode(i interface{}, path cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type to be string")
+	}
+
+	_, errs := validation.StringInSlice(cognitoidentityprovider.AdvancedSecurityModeType_Values(), false)(v, "advanced_security_mode")
+
+	if len(errs) > 0 {
+		var diags diag.Diagnostics
+
+		for _, err := range errs {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       err.Error(),
+				AttributePath: path,
+			})
+		}
+
+		return diags
+	}
+
+	if v == cognitoidentityprovider.AdvancedSecurityModeTypeOff {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity:      diag.Warning,
+				Summary:       "Cognito User Pool advanced security is OFF",
+				Detail:        "Threat protection (adaptive authentication and compromised credentials checks) will not be active for any client of this user pool, regardless of client settings.",
+				AttributePath: path,
+			},
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsCognitoUserPoolAccountRecoverySettingCustomizeDiff ensures
+// recovery mechanism priorities are unique, as required by the Cognito API.
+func resourceAwsCognitoUserPoolAccountRecoverySettingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("account_recovery_setting") {
+		return nil
+	}
+
+	tfList, ok := diff.Get("account_recovery_setting").([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	recoveryMechanisms, ok := tfMap["recovery_mechanism"].(*schema.Set)
+
+	if !ok {
+		return nil
 	}
+
+	priorities := make(map[int]struct{})
+
+	for _, tfMapRaw := range recoveryMechanisms.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		priority := tfMap["priority"].(int)
+
+		if _, ok := priorities[priority]; ok {
+			return fmt.Errorf("account_recovery_setting.0.recovery_mechanism priorities must be unique, found duplicate priority: %d", priority)
+		}
+
+		priorities[priority] = struct{}{}
+	}
+
+	return nil
 }
 
 func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{}) error {
@@ -587,19 +744,13 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if v, ok := d.GetOk("admin_create_user_config"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok && config != nil {
+		if config, ok := expandSingleNestedBlock(v); ok {
 			params.AdminCreateUserConfig = expandCognitoUserPoolAdminCreateUserConfig(config)
 		}
 	}
 
 	if v, ok := d.GetOk("account_recovery_setting"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok && config != nil {
+		if config, ok := expandSingleNestedBlock(v); ok {
 			params.AccountRecoverySetting = expandCognitoUserPoolAccountRecoverySettingConfig(config)
 		}
 	}
@@ -617,21 +768,16 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if v, ok := d.GetOk("admin_create_user_config"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok && config != nil {
+		if config, ok := expandSingleNestedBlock(v); ok {
 			params.AdminCreateUserConfig = expandCognitoUserPoolAdminCreateUserConfig(config)
 		}
 	}
 
-	if v, ok := d.GetOk("device_configuration"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok && config != nil {
-			params.DeviceConfiguration = expandCognitoUserPoolDeviceConfiguration(config)
-		}
+	if v, ok := d.GetOk("device_configuration"); ok && len(v.([]interface{})) > 0 {
+		// A block with only false values is still sent so that both
+		// booleans are stored and read back rather than the block being dropped.
+		config, _ := expandSingleNestedBlock(v)
+		params.DeviceConfiguration = expandCognitoUserPoolDeviceConfiguration(config)
 	}
 
 	if v, ok := d.GetOk("email_verification_subject"); ok {
@@ -643,19 +789,13 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if v, ok := d.GetOk("lambda_config"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok && config != nil {
+		if config, ok := expandSingleNestedBlock(v); ok {
 			params.LambdaConfig = expandCognitoUserPoolLambdaConfig(config)
 		}
 	}
 
 	if v, ok := d.GetOk("password_policy"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok && config != nil {
+		if config, ok := expandSingleNestedBlock(v); ok {
 			policies := &cognitoidentityprovider.UserPoolPolicyType{}
 			policies.PasswordPolicy = expandCognitoUserPoolPasswordPolicy(config)
 			params.Policies = policies
@@ -683,19 +823,13 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if v, ok := d.GetOk("username_configuration"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok && config != nil {
+		if config, ok := expandSingleNestedBlock(v); ok {
 			params.UsernameConfiguration = expandCognitoUserPoolUsernameConfiguration(config)
 		}
 	}
 
 	if v, ok := d.GetOk("user_pool_add_ons"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok {
+		if config, ok := expandSingleNestedBlock(v); ok {
 			userPoolAddons := &cognitoidentityprovider.UserPoolAddOnsType{}
 
 			if v, ok := config["advanced_security_mode"]; ok && v.(string) != "" {
@@ -706,10 +840,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	if v, ok := d.GetOk("verification_message_template"); ok {
-		configs := v.([]interface{})
-		config, ok := configs[0].(map[string]interface{})
-
-		if ok && config != nil {
+		if config, ok := expandSingleNestedBlock(v); ok {
 			params.VerificationMessageTemplate = expandCognitoUserPoolVerificationMessageTemplate(config)
 		}
 	}
@@ -718,8 +849,8 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if len(tags) > 0 {
-		params.UserPoolTags = tags.IgnoreAws().CognitoidentityproviderTags()
+	if createTags := meta.(*AWSClient).TagsOnCreate(tags); len(createTags) > 0 {
+		params.UserPoolTags = createTags.IgnoreAws().CognitoidentityproviderTags()
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
 
@@ -729,11 +860,12 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
 		var err error
 		resp, err = conn.CreateUserPool(params)
-		if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, "Role does not have a trust relationship allowing Cognito to assume the role") {
+		if waiter.IsPropagationError(err) {
 			log.Printf("[DEBUG] Received %s, retrying CreateUserPool", err)
 			return resource.RetryableError(err)
 		}
-		if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, "Role does not have permission to publish with SNS") {
+		// Bursts of requests can be throttled transiently
+		if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeTooManyRequestsException) {
 			log.Printf("[DEBUG] Received %s, retrying CreateUserPool", err)
 			return resource.RetryableError(err)
 		}
@@ -745,12 +877,26 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 	if isResourceTimeoutError(err) {
 		resp, err = conn.CreateUserPool(params)
 	}
+	// Reaching the maximum number of user pools in the account is not transient
+	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeLimitExceededException) {
+		return fmt.Errorf("error creating Cognito User Pool: user pool limit reached, request an increase of the user pools quota for this account: %w", err)
+	}
 	if err != nil {
-		return fmt.Errorf("error creating Cognito User Pool: %w", err)
+		return fmt.Errorf("error creating Cognito User Pool: %w", cognitoUserPoolLambdaVersionError(err))
 	}
 
 	d.SetId(aws.StringValue(resp.UserPool.Id))
 
+	if _, err := tfresource.WaitUntilExists(context.Background(), waiter.UserPoolStatus(conn, d.Id()), waiter.UserPoolPropagationTimeout); err != nil {
+		return fmt.Errorf("error waiting for Cognito User Pool (%s) to exist: %w", d.Id(), err)
+	}
+
+	if meta.(*AWSClient).skipTagOnCreate && len(tags) > 0 {
+		if err := keyvaluetags.CognitoidentityproviderUpdateTags(conn, aws.StringValue(resp.UserPool.Arn), nil, tags.IgnoreAws()); err != nil {
+			return fmt.Errorf("error adding Cognito User Pool (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	if v := d.Get("mfa_configuration").(string); v != cognitoidentityprovider.UserPoolMfaTypeOff {
 		input := &cognitoidentityprovider.SetUserPoolMfaConfigInput{
 			MfaConfiguration:              aws.String(v),
@@ -772,11 +918,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
 			_, err := conn.SetUserPoolMfaConfig(input)
 
-			if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, "Role does not have a trust relationship allowing Cognito to assume the role") {
-				return resource.RetryableError(err)
-			}
-
-			if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, "Role does not have permission to publish with SNS") {
+			if waiter.IsPropagationError(err) {
 				return resource.RetryableError(err)
 			}
 
@@ -808,7 +950,7 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 		UserPoolId: aws.String(d.Id()),
 	}
 
-	resp, err := conn.DescribeUserPool(params)
+	resp, err := describeCognitoUserPool(conn, params)
 
 	if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Cognito User Pool (%s) not found, removing from state", d.Id())
@@ -831,9 +973,18 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("arn", userPool.Arn)
 	d.Set("custom_domain", userPool.CustomDomain)
+
+	customDomainCertificateArn, customDomainCloudFrontDistribution, err := readCognitoUserPoolCustomDomain(conn, aws.StringValue(userPool.CustomDomain))
+
+	if err != nil {
+		return fmt.Errorf("error reading Cognito User Pool (%s) custom domain: %w", d.Id(), err)
+	}
+
+	d.Set("custom_domain_certificate_arn", customDomainCertificateArn)
+	d.Set("custom_domain_cloudfront_distribution", customDomainCloudFrontDistribution)
 	d.Set("domain", userPool.Domain)
 	d.Set("estimated_number_of_users", userPool.EstimatedNumberOfUsers)
-	d.Set("endpoint", fmt.Sprintf("%s/%s", meta.(*AWSClient).RegionalHostname("cognito-idp"), d.Id()))
+	d.Set("endpoint", cognitoUserPoolEndpoint(meta.(*AWSClient), d.Id()))
 	d.Set("auto_verified_attributes", flattenStringSet(userPool.AutoVerifiedAttributes))
 
 	if userPool.EmailVerificationSubject != nil {
@@ -842,7 +993,18 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 	if userPool.EmailVerificationMessage != nil {
 		d.Set("email_verification_message", userPool.EmailVerificationMessage)
 	}
-	if err := d.Set("lambda_config", flattenCognitoUserPoolLambdaConfig(userPool.LambdaConfig)); err != nil {
+	lambdaConfig := flattenCognitoUserPoolLambdaConfig(userPool.LambdaConfig)
+	if len(lambdaConfig) > 0 && userPool.LambdaConfig.KMSKeyID != nil {
+		// Keep a configured KMS alias in state if it resolves to the stored key ARN.
+		kmsKeyID, err := tfkms.NormalizeKeyARN(meta.(*AWSClient).kmsconn, d.Get("lambda_config.0.kms_key_id").(string), aws.StringValue(userPool.LambdaConfig.KMSKeyID), d.Get("resolve_kms_key_alias").(bool))
+
+		if err != nil {
+			log.Printf("[WARN] Cognito User Pool (%s): %s", d.Id(), err)
+		} else {
+			lambdaConfig[0]["kms_key_id"] = kmsKeyID
+		}
+	}
+	if err := d.Set("lambda_config", lambdaConfig); err != nil {
 		return fmt.Errorf("failed setting lambda_config: %w", err)
 	}
 	if userPool.SmsVerificationMessage != nil {
@@ -903,7 +1065,7 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("creation_date", userPool.CreationDate.Format(time.RFC3339))
 	d.Set("last_modified_date", userPool.LastModifiedDate.Format(time.RFC3339))
 	d.Set("name", userPool.Name)
-	tags := keyvaluetags.CognitoidentityKeyValueTags(userPool.UserPoolTags).IgnoreAws().IgnoreConfig(ignoreTagsConfig)
+	tags := keyvaluetags.CognitoidentityKeyValueTags(userPool.UserPoolTags).IgnoreCognito().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
@@ -914,11 +1076,13 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error setting tags_all: %w", err)
 	}
 
-	input := &cognitoidentityprovider.GetUserPoolMfaConfigInput{
-		UserPoolId: aws.String(d.Id()),
-	}
+	// Prior state only records the MFA configuration after an earlier read, so new and
+	// imported user pools (which have no mfa_configuration in state) always make the call.
+	priorMfaOff := !d.IsNewResource() &&
+		d.Get("mfa_configuration").(string) == cognitoidentityprovider.UserPoolMfaTypeOff &&
+		len(d.Get("software_token_mfa_configuration").([]interface{})) == 0
 
-	output, err := conn.GetUserPoolMfaConfig(input)
+	output, err := readCognitoUserPoolMfaConfig(conn, userPool, priorMfaOff)
 
 	if isAWSErr(err, cognitoidentityprovider.ErrCodeResourceNotFoundException, "") {
 		log.Printf("[WARN] Cognito User Pool (%s) not found, removing from state", d.Id())
@@ -939,6 +1103,79 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
+// cognitoUserPoolEndpoint returns the endpoint of the user pool. When a custom cognitoidp
+// endpoint is configured for the provider, e.g. for local testing, its host is used instead
+// of the regional hostname.
+func cognitoUserPoolEndpoint(client *AWSClient, id string) string {
+	hostname := client.RegionalHostname("cognito-idp")
+
+	if client.cognitoidpEndpoint == "" {
+		return fmt.Sprintf("%s/%s", hostname, id)
+	}
+
+	if u, err := url.Parse(client.cognitoidpEndpoint); err == nil && u.Host != "" {
+		hostname = u.Host + strings.TrimSuffix(u.Path, "/")
+	}
+
+	return fmt.Sprintf("%s/%s", hostname, id)
+}
+
+// describeCognitoUserPool describes the user pool, retrying when the request is throttled.
+func describeCognitoUserPool(conn *cognitoidentityprovider.CognitoIdentityProvider, input *cognitoidentityprovider.DescribeUserPoolInput) (*cognitoidentityprovider.DescribeUserPoolOutput, error) {
+	outputRaw, err := tfresource.RetryWhenAwsErrCodeEquals(waiter.UserPoolDescribeThrottleTimeout, func() (interface{}, error) {
+		return conn.DescribeUserPool(input)
+	}, cognitoidentityprovider.ErrCodeTooManyRequestsException)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*cognitoidentityprovider.DescribeUserPoolOutput), nil
+}
+
+// readCognitoUserPoolCustomDomain returns the ACM certificate ARN and CloudFront distribution
+// currently serving the user pool's custom domain, so that certificate rotation is reflected in state.
+func readCognitoUserPoolCustomDomain(conn *cognitoidentityprovider.CognitoIdentityProvider, customDomain string) (string, string, error) {
+	if customDomain == "" {
+		return "", "", nil
+	}
+
+	domain, err := finder.UserPoolDomainByName(conn, customDomain)
+
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Cognito User Pool custom domain (%s) not found", customDomain)
+		return "", "", nil
+	}
+
+	if err != nil {
+		return "", "", err
+	}
+
+	var certificateArn string
+	if domain.CustomDomainConfig != nil {
+		certificateArn = aws.StringValue(domain.CustomDomainConfig.CertificateArn)
+	}
+
+	return certificateArn, aws.StringValue(domain.CloudFrontDistribution), nil
+}
+
+// readCognitoUserPoolMfaConfig returns the MFA configuration of the user pool.
+// GetUserPoolMfaConfig is skipped only when DescribeUserPool reports MFA as OFF and
+// priorMfaOff confirms that the last read found MFA OFF with no software token MFA.
+func readCognitoUserPoolMfaConfig(conn *cognitoidentityprovider.CognitoIdentityProvider, userPool *cognitoidentityprovider.UserPoolType, priorMfaOff bool) (*cognitoidentityprovider.GetUserPoolMfaConfigOutput, error) {
+	if aws.StringValue(userPool.MfaConfiguration) == cognitoidentityprovider.UserPoolMfaTypeOff && priorMfaOff {
+		return &cognitoidentityprovider.GetUserPoolMfaConfigOutput{
+			MfaConfiguration: userPool.MfaConfiguration,
+		}, nil
+	}
+
+	input := &cognitoidentityprovider.GetUserPoolMfaConfigInput{
+		UserPoolId: userPool.Id,
+	}
+
+	return conn.GetUserPoolMfaConfig(input)
+}
+
 func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cognitoidpconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
@@ -975,11 +1212,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
 			_, err := conn.SetUserPoolMfaConfig(input)
 
-			if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, "Role does not have a trust relationship allowing Cognito to assume the role") {
-				return resource.RetryableError(err)
-			}
-
-			if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, "Role does not have permission to publish with SNS") {
+			if waiter.IsPropagationError(err) {
 				return resource.RetryableError(err)
 			}
 
@@ -1026,10 +1259,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		if v, ok := d.GetOk("admin_create_user_config"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
-
-			if ok && config != nil {
+			if config, ok := expandSingleNestedBlock(v); ok {
 				params.AdminCreateUserConfig = expandCognitoUserPoolAdminCreateUserConfig(config)
 			}
 		}
@@ -1039,21 +1269,14 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		if v, ok := d.GetOk("account_recovery_setting"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
-
-			if ok && config != nil {
+			if config, ok := expandSingleNestedBlock(v); ok {
 				params.AccountRecoverySetting = expandCognitoUserPoolAccountRecoverySettingConfig(config)
 			}
 		}
 
-		if v, ok := d.GetOk("device_configuration"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
-
-			if ok && config != nil {
-				params.DeviceConfiguration = expandCognitoUserPoolDeviceConfiguration(config)
-			}
+		if v, ok := d.GetOk("device_configuration"); ok && len(v.([]interface{})) > 0 {
+			config, _ := expandSingleNestedBlock(v)
+			params.DeviceConfiguration = expandCognitoUserPoolDeviceConfiguration(config)
 		}
 
 		if v, ok := d.GetOk("email_configuration"); ok && len(v.([]interface{})) > 0 {
@@ -1069,10 +1292,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		if v, ok := d.GetOk("lambda_config"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
-
-			if ok && config != nil {
+			if config, ok := expandSingleNestedBlock(v); ok {
 				params.LambdaConfig = expandCognitoUserPoolLambdaConfig(config)
 			}
 		}
@@ -1082,10 +1302,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		if v, ok := d.GetOk("password_policy"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
-
-			if ok && config != nil {
+			if config, ok := expandSingleNestedBlock(v); ok {
 				policies := &cognitoidentityprovider.UserPoolPolicyType{}
 				policies.PasswordPolicy = expandCognitoUserPoolPasswordPolicy(config)
 				params.Policies = policies
@@ -1101,10 +1318,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		if v, ok := d.GetOk("user_pool_add_ons"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
-
-			if ok && config != nil {
+			if config, ok := expandSingleNestedBlock(v); ok {
 				userPoolAddons := &cognitoidentityprovider.UserPoolAddOnsType{}
 
 				if v, ok := config["advanced_security_mode"]; ok && v.(string) != "" {
@@ -1115,20 +1329,17 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		}
 
 		if v, ok := d.GetOk("verification_message_template"); ok {
-			configs := v.([]interface{})
-			config, ok := configs[0].(map[string]interface{})
-
-			if d.HasChange("email_verification_message") {
-				config["email_message"] = d.Get("email_verification_message")
-			}
-			if d.HasChange("email_verification_subject") {
-				config["email_subject"] = d.Get("email_verification_subject")
-			}
-			if d.HasChange("sms_verification_message") {
-				config["sms_message"] = d.Get("sms_verification_message")
-			}
+			if config, ok := expandSingleNestedBlock(v); ok {
+				if d.HasChange("email_verification_message") {
+					config["email_message"] = d.Get("email_verification_message")
+				}
+				if d.HasChange("email_verification_subject") {
+					config["email_subject"] = d.Get("email_verification_subject")
+				}
+				if d.HasChange("sms_verification_message") {
+					config["sms_message"] = d.Get("sms_verification_message")
+				}
 
-			if ok && config != nil {
 				params.VerificationMessageTemplate = expandCognitoUserPoolVerificationMessageTemplate(config)
 			}
 		}
@@ -1147,15 +1358,14 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		// to the User Pool.
 		err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
 			_, err := conn.UpdateUserPool(params)
-			if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleTrustRelationshipException, "Role does not have a trust relationship allowing Cognito to assume the role") {
-				log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
-				return resource.RetryableError(err)
-			}
-			if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidSmsRoleAccessPolicyException, "Role does not have permission to publish with SNS") {
+			if waiter.IsPropagationError(err) {
 				log.Printf("[DEBUG] Received %s, retrying UpdateUserPool", err)
 				return resource.RetryableError(err)
 			}
 			if isAWSErr(err, cognitoidentityprovider.ErrCodeInvalidParameterException, "Please use TemporaryPasswordValidityDays in PasswordPolicy instead of UnusedAccountValidityDays") {
+				if params.AdminCreateUserConfig == nil || params.AdminCreateUserConfig.UnusedAccountValidityDays == nil {
+					return resource.NonRetryableError(err)
+				}
 				log.Printf("[DEBUG] Received %s, retrying UpdateUserPool without UnusedAccountValidityDays", err)
 				params.AdminCreateUserConfig.UnusedAccountValidityDays = nil
 				return resource.RetryableError(err)
@@ -1169,7 +1379,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 			_, err = conn.UpdateUserPool(params)
 		}
 		if err != nil {
-			return fmt.Errorf("error updating Cognito User pool (%s): %w", d.Id(), err)
+			return fmt.Errorf("error updating Cognito User pool (%s): %w", d.Id(), cognitoUserPoolLambdaVersionError(err))
 		}
 	}
 
@@ -1185,7 +1395,10 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 				return fmt.Errorf("error updating Cognito User Pool (%s): unable to add custom attributes from schema: %w", d.Id(), err)
 			}
 		} else {
-			return fmt.Errorf("error updating Cognito User Pool (%s): cannot modify or remove schema items", d.Id())
+			removed := oldSchema.(*schema.Set).Difference(newSchema.(*schema.Set)).List()
+			added := newSchema.(*schema.Set).Difference(oldSchema.(*schema.Set)).List()
+
+			return fmt.Errorf("error updating Cognito User Pool (%s): %w", d.Id(), cognitoUserPoolSchemaChangeError(removed, added))
 		}
 	}
 
@@ -1204,6 +1417,7 @@ func resourceAwsCognitoUserPoolDelete(d *schema.ResourceData, meta interface{})
 	_, err := conn.DeleteUserPool(params)
 
 	if tfawserr.ErrCodeEquals(err, cognitoidentityprovider.ErrCodeResourceNotFoundException) {
+		log.Printf("[DEBUG] Cognito User Pool (%s) already deleted", d.Id())
 		return nil
 	}
 
@@ -1434,21 +1648,21 @@ func flattenCognitoUserPoolAdminCreateUserConfig(s *cognitoidentityprovider.Admi
 		}
 	}
 
+	if s.UnusedAccountValidityDays != nil {
+		config["unused_account_validity_days"] = aws.Int64Value(s.UnusedAccountValidityDays)
+	}
+
 	return []map[string]interface{}{config}
 }
 
 func expandCognitoUserPoolDeviceConfiguration(config map[string]interface{}) *cognitoidentityprovider.DeviceConfigurationType {
-	configs := &cognitoidentityprovider.DeviceConfigurationType{}
-
-	if v, ok := config["challenge_required_on_new_device"]; ok {
-		configs.ChallengeRequiredOnNewDevice = aws.Bool(v.(bool))
-	}
+	challengeRequiredOnNewDevice, _ := config["challenge_required_on_new_device"].(bool)
+	deviceOnlyRememberedOnUserPrompt, _ := config["device_only_remembered_on_user_prompt"].(bool)
 
-	if v, ok := config["device_only_remembered_on_user_prompt"]; ok {
-		configs.DeviceOnlyRememberedOnUserPrompt = aws.Bool(v.(bool))
+	return &cognitoidentityprovider.DeviceConfigurationType{
+		ChallengeRequiredOnNewDevice:     aws.Bool(challengeRequiredOnNewDevice),
+		DeviceOnlyRememberedOnUserPrompt: aws.Bool(deviceOnlyRememberedOnUserPrompt),
 	}
-
-	return configs
 }
 
 func expandCognitoUserPoolLambdaConfig(config map[string]interface{}) *cognitoidentityprovider.LambdaConfigType {
@@ -1652,46 +1866,32 @@ func expandCognitoUserPoolSchema(inputs []interface{}) []*cognitoidentityprovide
 			config.Required = aws.Bool(v.(bool))
 		}
 
-		if v, ok := param["number_attribute_constraints"]; ok {
-			data := v.([]interface{})
-
-			if len(data) > 0 {
-				m, ok := data[0].(map[string]interface{})
-				if ok {
-					numberAttributeConstraintsType := &cognitoidentityprovider.NumberAttributeConstraintsType{}
-
-					if v, ok := m["min_value"]; ok && v.(string) != "" {
-						numberAttributeConstraintsType.MinValue = aws.String(v.(string))
-					}
+		if m, ok := expandSingleNestedBlock(param["number_attribute_constraints"]); ok {
+			numberAttributeConstraintsType := &cognitoidentityprovider.NumberAttributeConstraintsType{}
 
-					if v, ok := m["max_value"]; ok && v.(string) != "" {
-						numberAttributeConstraintsType.MaxValue = aws.String(v.(string))
-					}
-
-					config.NumberAttributeConstraints = numberAttributeConstraintsType
-				}
+			if v, ok := m["min_value"]; ok && v.(string) != "" {
+				numberAttributeConstraintsType.MinValue = aws.String(v.(string))
 			}
-		}
 
-		if v, ok := param["string_attribute_constraints"]; ok {
-			data := v.([]interface{})
+			if v, ok := m["max_value"]; ok && v.(string) != "" {
+				numberAttributeConstraintsType.MaxValue = aws.String(v.(string))
+			}
 
-			if len(data) > 0 {
-				m, _ := data[0].(map[string]interface{})
-				if ok {
-					stringAttributeConstraintsType := &cognitoidentityprovider.StringAttributeConstraintsType{}
+			config.NumberAttributeConstraints = numberAttributeConstraintsType
+		}
 
-					if l, ok := m["min_length"]; ok && l.(string) != "" {
-						stringAttributeConstraintsType.MinLength = aws.String(l.(string))
-					}
+		if m, ok := expandSingleNestedBlock(param["string_attribute_constraints"]); ok {
+			stringAttributeConstraintsType := &cognitoidentityprovider.StringAttributeConstraintsType{}
 
-					if l, ok := m["max_length"]; ok && l.(string) != "" {
-						stringAttributeConstraintsType.MaxLength = aws.String(l.(string))
-					}
+			if l, ok := m["min_length"]; ok && l.(string) != "" {
+				stringAttributeConstraintsType.MinLength = aws.String(l.(string))
+			}
 
-					config.StringAttributeConstraints = stringAttributeConstraintsType
-				}
+			if l, ok := m["max_length"]; ok && l.(string) != "" {
+				stringAttributeConstraintsType.MaxLength = aws.String(l.(string))
 			}
+
+			config.StringAttributeConstraints = stringAttributeConstraintsType
 		}
 
 		configs[i] = config
@@ -1714,7 +1914,7 @@ func flattenCognitoUserPoolSchema(configuredAttributes, inputs []*cognitoidentit
 		configured := false
 
 		for _, configuredAttribute := range configuredAttributes {
-			if reflect.DeepEqual(input, configuredAttribute) {
+			if cognitoUserPoolSchemaAttributesEqual(input, configuredAttribute) {
 				configured = true
 			}
 		}
@@ -1741,7 +1941,7 @@ func flattenCognitoUserPoolSchema(configuredAttributes, inputs []*cognitoidentit
 			"attribute_data_type":      aws.StringValue(input.AttributeDataType),
 			"developer_only_attribute": aws.BoolValue(input.DeveloperOnlyAttribute),
 			"mutable":                  aws.BoolValue(input.Mutable),
-			"name":                     strings.TrimPrefix(strings.TrimPrefix(aws.StringValue(input.Name), "dev:"), "custom:"),
+			"name":                     cognitoUserPoolSchemaAttributeName(aws.StringValue(input.Name)),
 			"required":                 aws.BoolValue(input.Required),
 		}
 
@@ -1868,18 +2068,14 @@ func flattenCognitoUserPoolVerificationMessageTemplate(s *cognitoidentityprovide
 }
 
 func flattenCognitoUserPoolDeviceConfiguration(s *cognitoidentityprovider.DeviceConfigurationType) []map[string]interface{} {
-	config := map[string]interface{}{}
-
 	if s == nil {
-		return nil
-	}
-
-	if s.ChallengeRequiredOnNewDevice != nil {
-		config["challenge_required_on_new_device"] = aws.BoolValue(s.ChallengeRequiredOnNewDevice)
+		return []map[string]interface{}{}
 	}
 
-	if s.DeviceOnlyRememberedOnUserPrompt != nil {
-		config["device_only_remembered_on_user_prompt"] = aws.BoolValue(s.DeviceOnlyRememberedOnUserPrompt)
+	// Unset booleans are omitted by the API and default to false on create.
+	config := map[string]interface{}{
+		"challenge_required_on_new_device":      aws.BoolValue(s.ChallengeRequiredOnNewDevice),
+		"device_only_remembered_on_user_prompt": aws.BoolValue(s.DeviceOnlyRememberedOnUserPrompt),
 	}
 
 	return []map[string]interface{}{config}
@@ -1923,6 +2119,70 @@ func flattenCognitoUserPoolPasswordPolicy(s *cognitoidentityprovider.PasswordPol
 	return []map[string]interface{}{}
 }
 
+// cognitoUserPoolSchemaChangeError describes why schema attributes removed from the
+// configuration cannot be applied. Cognito can only add custom attributes, so a rename
+// (one attribute removed and one added) is reported separately from modifications and removals.
+func cognitoUserPoolSchemaChangeError(removed, added []interface{}) error {
+	name := func(tfMapRaw interface{}) string {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			return ""
+		}
+
+		return tfMap["name"].(string)
+	}
+
+	addedNames := make(map[string]struct{})
+
+	for _, v := range added {
+		addedNames[name(v)] = struct{}{}
+	}
+
+	if len(removed) == 1 && len(added) == 1 {
+		if oldName, newName := name(removed[0]), name(added[0]); oldName != newName {
+			return fmt.Errorf("cannot rename schema attribute %q to %q: Cognito does not support renaming attributes, keep %q in the configuration and add %q as a new attribute", oldName, newName, oldName, newName)
+		}
+	}
+
+	var messages []string
+
+	for _, v := range removed {
+		n := name(v)
+
+		if _, ok := addedNames[n]; ok {
+			messages = append(messages, fmt.Sprintf("cannot modify schema attribute %q: Cognito does not support changing attributes, restore its previous settings and add a new attribute instead", n))
+		} else {
+			messages = append(messages, fmt.Sprintf("cannot remove schema attribute %q: Cognito does not support removing attributes, restore it in the configuration", n))
+		}
+	}
+
+	sort.Strings(messages)
+
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// cognitoUserPoolSchemaAttributeName returns the attribute name without the
+// "dev:" and "custom:" prefixes the API adds to developer only and custom attributes.
+func cognitoUserPoolSchemaAttributeName(name string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(name, "dev:"), "custom:")
+}
+
+// cognitoUserPoolSchemaAttributesEqual compares two schema attributes,
+// normalizing both names so that configured attributes match the prefixed
+// names returned by the API.
+func cognitoUserPoolSchemaAttributesEqual(a, b *cognitoidentityprovider.SchemaAttributeType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	x, y := *a, *b
+	x.Name = aws.String(cognitoUserPoolSchemaAttributeName(aws.StringValue(a.Name)))
+	y.Name = aws.String(cognitoUserPoolSchemaAttributeName(aws.StringValue(b.Name)))
+
+	return reflect.DeepEqual(x, y)
+}
+
 func cognitoUserPoolSchemaAttributeMatchesStandardAttribute(input *cognitoidentityprovider.SchemaAttributeType) bool {
 	if input == nil {
 		return false
@@ -2143,10 +2403,30 @@ func cognitoUserPoolSchemaAttributeMatchesStandardAttribute(input *cognitoidenti
 			},
 		},
 	}
+	// Attribute constraints are ignored as AWS may adjust the defaults
+	// of standard attributes, which would otherwise cause a large and unexpected diff.
 	for _, standardAttribute := range standardAttributes {
-		if reflect.DeepEqual(*input, standardAttribute) {
-			return true
+		if aws.StringValue(input.Name) != aws.StringValue(standardAttribute.Name) {
+			continue
+		}
+
+		if aws.StringValue(input.AttributeDataType) != aws.StringValue(standardAttribute.AttributeDataType) {
+			continue
 		}
+
+		if aws.BoolValue(input.DeveloperOnlyAttribute) != aws.BoolValue(standardAttribute.DeveloperOnlyAttribute) {
+			continue
+		}
+
+		if aws.BoolValue(input.Mutable) != aws.BoolValue(standardAttribute.Mutable) {
+			continue
+		}
+
+		if aws.BoolValue(input.Required) != aws.BoolValue(standardAttribute.Required) {
+			continue
+		}
+
+		return true
 	}
 	return false
 }
@@ -2222,3 +2502,13 @@ func expandCognitoUserPoolEmailConfig(emailConfig []interface{}) *cognitoidentit
 
 	return emailConfigurationType
 }
+
+// cognitoUserPoolLambdaVersionError adds guidance to the error returned by AWS
+// when a custom sender trigger is configured with an unsupported Lambda version.
+func cognitoUserPoolLambdaVersionError(err error) error {
+	if tfawserr.ErrMessageContains(err, cognitoidentityprovider.ErrCodeInvalidParameterException, "LambdaVersion") {
+		return fmt.Errorf("unsupported custom sender lambda_version, the only supported value is %q: %w", cognitoidentityprovider.CustomEmailSenderLambdaVersionTypeV10, err)
+	}
+
+	return err
+}
@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -172,6 +178,280 @@ func TestAccAWSCognitoUserPool_recovery(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_recoveryDuplicatePriority(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		ErrorCheck:   testAccErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCognitoUserPoolConfigAccountRecoveryDuplicatePriority(rName),
+				ExpectError: regexp.MustCompile(`found duplicate priority: 1`),
+			},
+		},
+	})
+}
+
+func TestFlattenCognitoUserPoolAdminCreateUserConfig(t *testing.T) {
+	testCases := []struct {
+		name      string
+		apiObject *cognitoidentityprovider.AdminCreateUserConfigType
+		expected  []map[string]interface{}
+	}{
+		{
+			name:      "nil",
+			apiObject: nil,
+			expected:  nil,
+		},
+		{
+			name: "without unused account validity days",
+			apiObject: &cognitoidentityprovider.AdminCreateUserConfigType{
+				AllowAdminCreateUserOnly: aws.Bool(true),
+			},
+			expected: []map[string]interface{}{
+				{"allow_admin_create_user_only": true},
+			},
+		},
+		{
+			name: "with unused account validity days",
+			apiObject: &cognitoidentityprovider.AdminCreateUserConfigType{
+				AllowAdminCreateUserOnly:  aws.Bool(false),
+				UnusedAccountValidityDays: aws.Int64(30),
+			},
+			expected: []map[string]interface{}{
+				{"allow_admin_create_user_only": false, "unused_account_validity_days": int64(30)},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := flattenCognitoUserPoolAdminCreateUserConfig(testCase.apiObject)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenCognitoUserPoolSchema(t *testing.T) {
+	configured := []*cognitoidentityprovider.SchemaAttributeType{
+		{
+			AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+			DeveloperOnlyAttribute: aws.Bool(true),
+			Mutable:                aws.Bool(false),
+			Name:                   aws.String("mybool"),
+			Required:               aws.Bool(false),
+		},
+	}
+
+	inputs := []*cognitoidentityprovider.SchemaAttributeType{
+		{
+			AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeBoolean),
+			DeveloperOnlyAttribute: aws.Bool(true),
+			Mutable:                aws.Bool(false),
+			Name:                   aws.String("dev:custom:mybool"),
+			Required:               aws.Bool(false),
+		},
+		{
+			AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+			DeveloperOnlyAttribute: aws.Bool(false),
+			Mutable:                aws.Bool(true),
+			Name:                   aws.String("address"),
+			Required:               aws.Bool(false),
+			StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+				MaxLength: aws.String("2048"),
+				MinLength: aws.String("0"),
+			},
+		},
+	}
+
+	if !cognitoUserPoolSchemaAttributesEqual(inputs[0], configured[0]) {
+		t.Errorf("expected %q to match configured attribute %q", aws.StringValue(inputs[0].Name), aws.StringValue(configured[0].Name))
+	}
+
+	got := flattenCognitoUserPoolSchema(configured, inputs)
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 schema attribute, got %d: %v", len(got), got)
+	}
+
+	if name := got[0]["name"]; name != "mybool" {
+		t.Errorf("got name %q, expected %q", name, "mybool")
+	}
+
+	if developerOnly := got[0]["developer_only_attribute"]; developerOnly != true {
+		t.Errorf("got developer_only_attribute %v, expected true", developerOnly)
+	}
+}
+
+func TestFlattenCognitoUserPoolDeviceConfiguration(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *cognitoidentityprovider.DeviceConfigurationType
+		Expected []map[string]interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: []map[string]interface{}{},
+		},
+		{
+			Name:  "unset",
+			Input: &cognitoidentityprovider.DeviceConfigurationType{},
+			Expected: []map[string]interface{}{{
+				"challenge_required_on_new_device":      false,
+				"device_only_remembered_on_user_prompt": false,
+			}},
+		},
+		{
+			Name: "challenge required only",
+			Input: &cognitoidentityprovider.DeviceConfigurationType{
+				ChallengeRequiredOnNewDevice: aws.Bool(true),
+			},
+			Expected: []map[string]interface{}{{
+				"challenge_required_on_new_device":      true,
+				"device_only_remembered_on_user_prompt": false,
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenCognitoUserPoolDeviceConfiguration(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestCognitoUserPoolLambdaConfigKmsKeyWarning(t *testing.T) {
+	testCases := []struct {
+		name        string
+		tfMap       map[string]interface{}
+		expectedMsg bool
+	}{
+		{
+			name:  "no kms key",
+			tfMap: map[string]interface{}{},
+		},
+		{
+			name: "kms key without custom sender",
+			tfMap: map[string]interface{}{
+				"kms_key_id": "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+			},
+			expectedMsg: true,
+		},
+		{
+			name: "kms key with empty custom senders",
+			tfMap: map[string]interface{}{
+				"kms_key_id":          "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+				"custom_email_sender": []interface{}{},
+				"custom_sms_sender":   []interface{}{},
+			},
+			expectedMsg: true,
+		},
+		{
+			name: "kms key with custom email sender",
+			tfMap: map[string]interface{}{
+				"kms_key_id": "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+				"custom_email_sender": []interface{}{
+					map[string]interface{}{
+						"lambda_arn":     "arn:aws:lambda:us-west-2:123456789012:function:test",
+						"lambda_version": cognitoidentityprovider.CustomEmailSenderLambdaVersionTypeV10,
+					},
+				},
+			},
+		},
+		{
+			name: "kms key with custom sms sender",
+			tfMap: map[string]interface{}{
+				"kms_key_id": "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012",
+				"custom_sms_sender": []interface{}{
+					map[string]interface{}{
+						"lambda_arn":     "arn:aws:lambda:us-west-2:123456789012:function:test",
+						"lambda_version": cognitoidentityprovider.CustomSMSSenderLambdaVersionTypeV10,
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg := cognitoUserPoolLambdaConfigKmsKeyWarning(testCase.tfMap)
+
+			if testCase.expectedMsg && msg == "" {
+				t.Fatal("expected warning, got none")
+			}
+
+			if !testCase.expectedMsg && msg != "" {
+				t.Fatalf("unexpected warning: %s", msg)
+			}
+		})
+	}
+}
+
+func TestResourceAwsCognitoUserPoolValidateAdvancedSecurityMode(t *testing.T) {
+	testCases := []struct {
+		name            string
+		value           interface{}
+		expectedWarning bool
+		expectedError   bool
+	}{
+		{
+			name:  "audit",
+			value: cognitoidentityprovider.AdvancedSecurityModeTypeAudit,
+		},
+		{
+			name:  "enforced",
+			value: cognitoidentityprovider.AdvancedSecurityModeTypeEnforced,
+		},
+		{
+			name:            "off",
+			value:           cognitoidentityprovider.AdvancedSecurityModeTypeOff,
+			expectedWarning: true,
+		},
+		{
+			name:          "invalid",
+			value:         "DISABLED",
+			expectedError: true,
+		},
+		{
+			name:          "not a string",
+			value:         1,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			diags := resourceAwsCognitoUserPoolValidateAdvancedSecurityMode(testCase.value, cty.GetAttrPath("advanced_security_mode"))
+
+			if got := diags.HasError(); got != testCase.expectedError {
+				t.Fatalf("expected error %t, got diagnostics: %#v", testCase.expectedError, diags)
+			}
+
+			var warning bool
+			for _, d := range diags {
+				if d.Severity == diag.Warning {
+					warning = true
+				}
+			}
+
+			if warning != testCase.expectedWarning {
+				t.Fatalf("expected warning %t, got diagnostics: %#v", testCase.expectedWarning, diags)
+			}
+		})
+	}
+}
+
 func TestAccAWSCognitoUserPool_withAdminCreateUserConfiguration(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_cognito_user_pool.test"
@@ -310,6 +590,38 @@ func TestAccAWSCognitoUserPool_withDeviceConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_withDeviceConfigurationChallengeRequiredOnly(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		ErrorCheck:   testAccErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_withDeviceConfigurationChallengeRequiredOnly(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists(resourceName, nil),
+					resource.TestCheckResourceAttr(resourceName, "device_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "device_configuration.0.challenge_required_on_new_device", "true"),
+					resource.TestCheckResourceAttr(resourceName, "device_configuration.0.device_only_remembered_on_user_prompt", "false"),
+				),
+			},
+			{
+				Config:   testAccAWSCognitoUserPoolConfig_withDeviceConfigurationChallengeRequiredOnly(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSCognitoUserPool_withEmailVerificationMessage(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	subject := acctest.RandString(10)
@@ -1109,8 +1421,13 @@ func TestAccAWSCognitoUserPool_withLambdaConfig_emailConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "lambda_config.0.custom_email_sender.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "lambda_config.0.custom_email_sender.0.lambda_arn", lambdaResourceName, "arn"),
 					resource.TestCheckResourceAttr(resourceName, "lambda_config.0.custom_email_sender.0.lambda_version", "V1_0"),
+					resource.TestCheckResourceAttr(resourceName, "lambda_config.0.custom_sms_sender.#", "0"),
 				),
 			},
+			{
+				Config:   testAccAWSCognitoUserPoolLambdaConfigEmailSenderConfig(rName),
+				PlanOnly: true,
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -1129,6 +1446,36 @@ func TestAccAWSCognitoUserPool_withLambdaConfig_emailConfig(t *testing.T) {
 	})
 }
 
+func TestFlattenCognitoUserPoolLambdaConfig_customSenders(t *testing.T) {
+	apiObject := &cognitoidentityprovider.LambdaConfigType{
+		KMSKeyID: aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+		CustomEmailSender: &cognitoidentityprovider.CustomEmailLambdaVersionConfigType{
+			LambdaArn:     aws.String("arn:aws:lambda:us-west-2:123456789012:function:sender"),
+			LambdaVersion: aws.String(cognitoidentityprovider.CustomEmailSenderLambdaVersionTypeV10),
+		},
+	}
+
+	flattened := flattenCognitoUserPoolLambdaConfig(apiObject)
+
+	if len(flattened) != 1 {
+		t.Fatalf("expected 1 lambda_config, got %d", len(flattened))
+	}
+
+	emailSender, ok := flattened[0]["custom_email_sender"].([]map[string]interface{})
+
+	if !ok || len(emailSender) != 1 {
+		t.Fatalf("expected custom_email_sender to be a single-element list, got %#v", flattened[0]["custom_email_sender"])
+	}
+
+	if got, expected := emailSender[0]["lambda_arn"], aws.StringValue(apiObject.CustomEmailSender.LambdaArn); got != expected {
+		t.Errorf("got lambda_arn %v, expected %s", got, expected)
+	}
+
+	if v, ok := flattened[0]["custom_sms_sender"]; ok {
+		t.Errorf("expected no custom_sms_sender, got %#v", v)
+	}
+}
+
 func TestAccAWSCognitoUserPool_withLambdaConfig_smsConfig(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_cognito_user_pool.test"
@@ -1169,6 +1516,340 @@ func TestAccAWSCognitoUserPool_withLambdaConfig_smsConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_nameTooLong(t *testing.T) {
+	rName := strings.Repeat("a", 130)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		ErrorCheck:   testAccErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCognitoUserPoolConfig_Name(rName),
+				ExpectError: regexp.MustCompile(`expected length of name to be in the range \(1 - 128\)`),
+			},
+		},
+	})
+}
+
+func TestAccAWSCognitoUserPool_withLambdaConfig_invalidLambdaVersion(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		ErrorCheck:   testAccErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSCognitoUserPoolLambdaConfigEmailSenderConfigLambdaVersion(rName, "V2_0"),
+				ExpectError: regexp.MustCompile(`expected lambda_config.0.custom_email_sender.0.lambda_version to be one of`),
+			},
+		},
+	})
+}
+
+func TestCognitoUserPoolSchemaChangeError(t *testing.T) {
+	attribute := func(name, dataType string) map[string]interface{} {
+		return map[string]interface{}{
+			"attribute_data_type": dataType,
+			"name":                name,
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		removed  []interface{}
+		added    []interface{}
+		expected string
+	}{
+		{
+			name:     "rename",
+			removed:  []interface{}{attribute("email2", cognitoidentityprovider.AttributeDataTypeString)},
+			added:    []interface{}{attribute("email3", cognitoidentityprovider.AttributeDataTypeString)},
+			expected: `cannot rename schema attribute "email2" to "email3": Cognito does not support renaming attributes, keep "email2" in the configuration and add "email3" as a new attribute`,
+		},
+		{
+			name:     "remove",
+			removed:  []interface{}{attribute("email2", cognitoidentityprovider.AttributeDataTypeString)},
+			expected: `cannot remove schema attribute "email2": Cognito does not support removing attributes, restore it in the configuration`,
+		},
+		{
+			name:     "modify",
+			removed:  []interface{}{attribute("email2", cognitoidentityprovider.AttributeDataTypeString)},
+			added:    []interface{}{attribute("email2", cognitoidentityprovider.AttributeDataTypeNumber)},
+			expected: `cannot modify schema attribute "email2": Cognito does not support changing attributes, restore its previous settings and add a new attribute instead`,
+		},
+		{
+			name: "remove multiple",
+			removed: []interface{}{
+				attribute("email3", cognitoidentityprovider.AttributeDataTypeString),
+				attribute("email2", cognitoidentityprovider.AttributeDataTypeString),
+			},
+			added:    []interface{}{attribute("email4", cognitoidentityprovider.AttributeDataTypeString)},
+			expected: `cannot remove schema attribute "email2": Cognito does not support removing attributes, restore it in the configuration; cannot remove schema attribute "email3": Cognito does not support removing attributes, restore it in the configuration`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := cognitoUserPoolSchemaChangeError(testCase.removed, testCase.added)
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if got := err.Error(); got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestCognitoUserPoolEndpoint(t *testing.T) {
+	testCases := []struct {
+		name     string
+		endpoint string
+		expected string
+	}{
+		{
+			name:     "default",
+			expected: "cognito-idp.us-west-2.amazonaws.com/us-west-2_aBcDeFgHi",
+		},
+		{
+			name:     "custom",
+			endpoint: "http://localhost:4566",
+			expected: "localhost:4566/us-west-2_aBcDeFgHi",
+		},
+		{
+			name:     "custom with path",
+			endpoint: "https://cognito.example.com/proxy/",
+			expected: "cognito.example.com/proxy/us-west-2_aBcDeFgHi",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client := &AWSClient{
+				cognitoidpEndpoint: testCase.endpoint,
+				dnsSuffix:          "amazonaws.com",
+				region:             "us-west-2",
+			}
+
+			if got := cognitoUserPoolEndpoint(client, "us-west-2_aBcDeFgHi"); got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestDescribeCognitoUserPool(t *testing.T) {
+	testCases := []struct {
+		name       string
+		errs       []error
+		wantErr    bool
+		wantCalls  int
+		wantPoolID string
+	}{
+		{
+			name:       "success",
+			wantCalls:  1,
+			wantPoolID: "us-west-2_aBcDeFgHi",
+		},
+		{
+			name:       "throttled",
+			errs:       []error{awserr.New(cognitoidentityprovider.ErrCodeTooManyRequestsException, "Rate exceeded", nil)},
+			wantCalls:  2,
+			wantPoolID: "us-west-2_aBcDeFgHi",
+		},
+		{
+			name:      "not found",
+			errs:      []error{awserr.New(cognitoidentityprovider.ErrCodeResourceNotFoundException, "User pool does not exist", nil)},
+			wantErr:   true,
+			wantCalls: 1,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := cognitoidentityprovider.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var calls int
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if data, ok := r.Data.(*cognitoidentityprovider.DescribeUserPoolOutput); ok {
+					if calls < len(testCase.errs) {
+						r.Error = testCase.errs[calls]
+					} else {
+						data.UserPool = &cognitoidentityprovider.UserPoolType{
+							Id: aws.String("us-west-2_aBcDeFgHi"),
+						}
+					}
+					calls++
+				}
+			})
+
+			output, err := describeCognitoUserPool(conn, &cognitoidentityprovider.DescribeUserPoolInput{
+				UserPoolId: aws.String("us-west-2_aBcDeFgHi"),
+			})
+
+			if testCase.wantErr && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if calls != testCase.wantCalls {
+				t.Errorf("expected %d DescribeUserPool calls, got %d", testCase.wantCalls, calls)
+			}
+
+			if !testCase.wantErr {
+				if got := aws.StringValue(output.UserPool.Id); got != testCase.wantPoolID {
+					t.Errorf("expected user pool ID %s, got %s", testCase.wantPoolID, got)
+				}
+			}
+		})
+	}
+}
+
+func TestReadCognitoUserPoolCustomDomain(t *testing.T) {
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := cognitoidentityprovider.New(sess)
+
+	// Simulate the custom domain's certificate being rotated between reads.
+	certificateArns := []string{
+		"arn:aws:acm:us-east-1:123456789012:certificate/11111111-1111-1111-1111-111111111111", //lintignore:AWSAT003,AWSAT005
+		"arn:aws:acm:us-east-1:123456789012:certificate/22222222-2222-2222-2222-222222222222", //lintignore:AWSAT003,AWSAT005
+	}
+	var calls int
+
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		if data, ok := r.Data.(*cognitoidentityprovider.DescribeUserPoolDomainOutput); ok {
+			data.DomainDescription = &cognitoidentityprovider.DomainDescriptionType{
+				CloudFrontDistribution: aws.String("d111111abcdef8.cloudfront.net"),
+				CustomDomainConfig: &cognitoidentityprovider.CustomDomainConfigType{
+					CertificateArn: aws.String(certificateArns[calls]),
+				},
+				Domain: aws.String("auth.example.com"),
+				Status: aws.String(cognitoidentityprovider.DomainStatusTypeActive),
+			}
+			calls++
+		}
+	})
+
+	for i, expected := range certificateArns {
+		certificateArn, cloudFrontDistribution, err := readCognitoUserPoolCustomDomain(conn, "auth.example.com")
+
+		if err != nil {
+			t.Fatalf("read %d: unexpected error: %s", i, err)
+		}
+
+		if certificateArn != expected {
+			t.Errorf("read %d: expected certificate ARN %s, got %s", i, expected, certificateArn)
+		}
+
+		if cloudFrontDistribution != "d111111abcdef8.cloudfront.net" {
+			t.Errorf("read %d: expected CloudFront distribution d111111abcdef8.cloudfront.net, got %s", i, cloudFrontDistribution)
+		}
+	}
+
+	if _, _, err := readCognitoUserPoolCustomDomain(conn, ""); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls != len(certificateArns) {
+		t.Errorf("expected %d DescribeUserPoolDomain calls, got %d", len(certificateArns), calls)
+	}
+}
+
+func TestReadCognitoUserPoolMfaConfig(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mfaConfiguration     string
+		priorMfaOff          bool
+		wantCalls            int
+		wantMfaConfiguration string
+	}{
+		{
+			name:                 "off",
+			mfaConfiguration:     cognitoidentityprovider.UserPoolMfaTypeOff,
+			priorMfaOff:          true,
+			wantCalls:            0,
+			wantMfaConfiguration: cognitoidentityprovider.UserPoolMfaTypeOff,
+		},
+		{
+			name:                 "off without prior read",
+			mfaConfiguration:     cognitoidentityprovider.UserPoolMfaTypeOff,
+			wantCalls:            1,
+			wantMfaConfiguration: cognitoidentityprovider.UserPoolMfaTypeOn,
+		},
+		{
+			name:                 "on",
+			mfaConfiguration:     cognitoidentityprovider.UserPoolMfaTypeOn,
+			priorMfaOff:          true,
+			wantCalls:            1,
+			wantMfaConfiguration: cognitoidentityprovider.UserPoolMfaTypeOn,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := cognitoidentityprovider.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var calls int
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if data, ok := r.Data.(*cognitoidentityprovider.GetUserPoolMfaConfigOutput); ok {
+					calls++
+					data.MfaConfiguration = aws.String(cognitoidentityprovider.UserPoolMfaTypeOn)
+				}
+			})
+
+			userPool := &cognitoidentityprovider.UserPoolType{
+				Id:               aws.String("us-west-2_aBcDeFgHi"),
+				MfaConfiguration: aws.String(testCase.mfaConfiguration),
+			}
+
+			output, err := readCognitoUserPoolMfaConfig(conn, userPool, testCase.priorMfaOff)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if calls != testCase.wantCalls {
+				t.Errorf("expected %d GetUserPoolMfaConfig calls, got %d", testCase.wantCalls, calls)
+			}
+
+			if got := aws.StringValue(output.MfaConfiguration); got != testCase.wantMfaConfiguration {
+				t.Errorf("expected MFA configuration %s, got %s", testCase.wantMfaConfiguration, got)
+			}
+		})
+	}
+}
+
 func TestAccAWSCognitoUserPool_schemaAttributes(t *testing.T) {
 	var pool1, pool2 cognitoidentityprovider.DescribeUserPoolOutput
 	rName := acctest.RandomWithPrefix("tf-acc-test")
@@ -1207,6 +1888,10 @@ func TestAccAWSCognitoUserPool_schemaAttributes(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config:   testAccAWSCognitoUserPoolConfig_withSchemaAttributes(rName),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccAWSCognitoUserPoolConfig_withSchemaAttributesUpdated(rName, "mybool"),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -1269,7 +1954,7 @@ func TestAccAWSCognitoUserPool_schemaAttributesRemoved(t *testing.T) {
 			},
 			{
 				Config:      testAccAWSCognitoUserPoolConfig_withSchemaAttributes(rName),
-				ExpectError: regexp.MustCompile("cannot modify or remove schema items"),
+				ExpectError: regexp.MustCompile(`cannot remove schema attribute "mynondevnumber"`),
 			},
 		},
 	})
@@ -1289,7 +1974,7 @@ func TestAccAWSCognitoUserPool_schemaAttributesModified(t *testing.T) {
 			},
 			{
 				Config:      testAccAWSCognitoUserPoolConfig_withSchemaAttributesUpdated(rName, "mybool2"),
-				ExpectError: regexp.MustCompile("cannot modify or remove schema items"),
+				ExpectError: regexp.MustCompile(`cannot rename schema attribute "mybool" to "mybool2"`),
 			},
 		},
 	})
@@ -1460,6 +2145,30 @@ func TestAccAWSCognitoUserPool_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAWSCognitoUserPool_disappears_alreadyDeleted(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSCognitoIdentityProvider(t) },
+		ErrorCheck:   testAccErrorCheck(t, cognitoidentityprovider.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSCognitoUserPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSCognitoUserPoolConfig_Name(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSCognitoUserPoolExists(resourceName, nil),
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCognitoUserPool(), resourceName),
+					// Deleting a user pool that no longer exists must succeed.
+					testAccCheckResourceDisappears(testAccProvider, resourceAwsCognitoUserPool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSCognitoUserPoolDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).cognitoidpconn
 
@@ -1616,6 +2325,26 @@ resource "aws_cognito_user_pool" "test" {
 `, rName)
 }
 
+func testAccAWSCognitoUserPoolConfigAccountRecoveryDuplicatePriority(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  account_recovery_setting {
+    recovery_mechanism {
+      name     = "verified_email"
+      priority = 1
+    }
+
+    recovery_mechanism {
+      name     = "verified_phone_number"
+      priority = 1
+    }
+  }
+}
+`, rName)
+}
+
 func testAccAWSCognitoUserPoolConfigAccountRecoveryUpdate(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "test" {
@@ -1692,6 +2421,18 @@ resource "aws_cognito_user_pool" "test" {
 `, rName)
 }
 
+func testAccAWSCognitoUserPoolConfig_withDeviceConfigurationChallengeRequiredOnly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  device_configuration {
+    challenge_required_on_new_device = true
+  }
+}
+`, rName)
+}
+
 func testAccAWSCognitoUserPoolConfig_withDeviceConfigurationUpdated(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "test" {
@@ -2121,6 +2862,23 @@ resource "aws_cognito_user_pool" "test" {
 `, name)
 }
 
+func testAccAWSCognitoUserPoolLambdaConfigEmailSenderConfigLambdaVersion(name, lambdaVersion string) string {
+	return testAccAWSCognitoUserPoolConfigLambdaConfigBase(name) + fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+
+  lambda_config {
+    kms_key_id = aws_kms_key.test.arn
+
+    custom_email_sender {
+      lambda_arn     = aws_lambda_function.test.arn
+      lambda_version = %[2]q
+    }
+  }
+}
+`, name, lambdaVersion)
+}
+
 func testAccAWSCognitoUserPoolLambdaConfigEmailSenderConfigUpdated(name string) string {
 	return testAccAWSCognitoUserPoolConfigLambdaConfigBase(name) + fmt.Sprintf(`
 resource "aws_lambda_function" "second" {
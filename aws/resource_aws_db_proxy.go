@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
 )
 
 func resourceAwsDbProxy() *schema.Resource {
@@ -47,12 +48,13 @@ func resourceAwsDbProxy() *schema.Resource {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice(rds.EngineFamily_Values(), false),
+				ValidateFunc: validation.StringInSlice(tfrds.EngineFamily_Values(), false),
 			},
 			"idle_client_timeout": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 28800),
 			},
 			"require_tls": {
 				Type:     schema.TypeBool,
@@ -282,15 +284,21 @@ func resourceAwsDbProxyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("arn", dbProxy.DBProxyArn)
-	d.Set("auth", flattenDbProxyAuths(dbProxy.Auth))
+	if err := d.Set("auth", flattenDbProxyAuths(dbProxy.Auth)); err != nil {
+		return fmt.Errorf("error setting auth: %w", err)
+	}
 	d.Set("name", dbProxy.DBProxyName)
 	d.Set("debug_logging", dbProxy.DebugLogging)
 	d.Set("engine_family", dbProxy.EngineFamily)
 	d.Set("idle_client_timeout", dbProxy.IdleClientTimeout)
 	d.Set("require_tls", dbProxy.RequireTLS)
 	d.Set("role_arn", dbProxy.RoleArn)
-	d.Set("vpc_subnet_ids", flattenStringSet(dbProxy.VpcSubnetIds))
-	d.Set("vpc_security_group_ids", flattenStringSet(dbProxy.VpcSecurityGroupIds))
+	if err := d.Set("vpc_subnet_ids", flattenStringSet(dbProxy.VpcSubnetIds)); err != nil {
+		return fmt.Errorf("error setting vpc_subnet_ids: %w", err)
+	}
+	if err := d.Set("vpc_security_group_ids", flattenStringSet(dbProxy.VpcSecurityGroupIds)); err != nil {
+		return fmt.Errorf("error setting vpc_security_group_ids: %w", err)
+	}
 	d.Set("endpoint", dbProxy.Endpoint)
 
 	tags, err := keyvaluetags.RdsListTags(conn, d.Get("arn").(string))
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfrds "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/rds"
 )
 
 func init() {
@@ -105,6 +106,47 @@ func TestAccAWSDBProxy_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBProxy_import(t *testing.T) {
+	var v rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyExists(resourceName, &v),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					rs := s[0]
+
+					for _, k := range []string{"auth.#", "engine_family", "role_arn", "vpc_subnet_ids.#"} {
+						if v := rs.Attributes[k]; v == "" || v == "0" {
+							return fmt.Errorf("expected %s to be populated on import, received: %q", k, v)
+						}
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccAWSDBProxy_Name(t *testing.T) {
 	var dbProxy rds.DBProxy
 	resourceName := "aws_db_proxy.test"
@@ -140,6 +182,104 @@ func TestAccAWSDBProxy_Name(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBProxy_EngineFamily_Mysql(t *testing.T) {
+	var dbProxy rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyConfigEngineFamily(rName, rds.EngineFamilyMysql),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyExists(resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "engine_family", rds.EngineFamilyMysql),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxy_EngineFamily_Postgresql(t *testing.T) {
+	var dbProxy rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyConfigEngineFamily(rName, rds.EngineFamilyPostgresql),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyExists(resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "engine_family", rds.EngineFamilyPostgresql),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxy_EngineFamily_SqlServer(t *testing.T) {
+	var dbProxy rds.DBProxy
+	resourceName := "aws_db_proxy.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSDBProxyConfigEngineFamily(rName, tfrds.EngineFamilySQLServer),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSDBProxyExists(resourceName, &dbProxy),
+					resource.TestCheckResourceAttr(resourceName, "engine_family", tfrds.EngineFamilySQLServer),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSDBProxy_EngineFamily_Invalid(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSDBProxyConfigEngineFamily(rName, "ORACLE"),
+				ExpectError: regexp.MustCompile(`expected engine_family to be one of`),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBProxy_DebugLogging(t *testing.T) {
 	var dbProxy rds.DBProxy
 	resourceName := "aws_db_proxy.test"
@@ -208,6 +348,27 @@ func TestAccAWSDBProxy_IdleClientTimeout(t *testing.T) {
 	})
 }
 
+func TestAccAWSDBProxy_IdleClientTimeout_Invalid(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccDBProxyPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, rds.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSDBProxyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSDBProxyConfigIdleClientTimeout(rName, 0),
+				ExpectError: regexp.MustCompile(`expected idle_client_timeout to be in the range \(1 - 28800\)`),
+			},
+			{
+				Config:      testAccAWSDBProxyConfigIdleClientTimeout(rName, 28801),
+				ExpectError: regexp.MustCompile(`expected idle_client_timeout to be in the range \(1 - 28800\)`),
+			},
+		},
+	})
+}
+
 func TestAccAWSDBProxy_RequireTls(t *testing.T) {
 	var dbProxy rds.DBProxy
 	resourceName := "aws_db_proxy.test"
@@ -676,6 +837,30 @@ resource "aws_db_proxy" "test" {
 `, rName)
 }
 
+func testAccAWSDBProxyConfigEngineFamily(rName, engineFamily string) string {
+	return testAccAWSDBProxyConfigBase(rName) + fmt.Sprintf(`
+resource "aws_db_proxy" "test" {
+  depends_on = [
+    aws_secretsmanager_secret_version.test,
+    aws_iam_role_policy.test
+  ]
+
+  name                   = %[1]q
+  engine_family          = %[2]q
+  role_arn               = aws_iam_role.test.arn
+  vpc_security_group_ids = [aws_security_group.test.id]
+  vpc_subnet_ids         = aws_subnet.test.*.id
+
+  auth {
+    auth_scheme = "SECRETS"
+    description = "test"
+    iam_auth    = "DISABLED"
+    secret_arn  = aws_secretsmanager_secret.test.arn
+  }
+}
+`, rName, engineFamily)
+}
+
 func testAccAWSDBProxyConfigName(rName, nName string) string {
 	return testAccAWSDBProxyConfigBase(rName) + fmt.Sprintf(`
 resource "aws_db_proxy" "test" {
@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,10 +11,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tffirehose "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/firehose"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/firehose/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/firehose/waiter"
 	iamwaiter "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/iam/waiter"
@@ -29,6 +32,16 @@ const (
 	firehoseDestinationTypeHttpEndpoint  = "http_endpoint"
 )
 
+// firehoseProcessingConfigurationKeys are the destination configuration blocks
+// that support a processing_configuration block.
+var firehoseProcessingConfigurationKeys = []string{
+	"elasticsearch_configuration",
+	"extended_s3_configuration",
+	"http_endpoint_configuration",
+	"redshift_configuration",
+	"splunk_configuration",
+}
+
 func cloudWatchLoggingOptionsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -175,7 +188,7 @@ func processingConfigurationSchema() *schema.Schema {
 										"parameter_name": {
 											Type:         schema.TypeString,
 											Required:     true,
-											ValidateFunc: validation.StringInSlice(firehose.ProcessorParameterName_Values(), false),
+											ValidateFunc: validation.StringInSlice(tffirehose.ProcessorParameterName_Values(), false),
 										},
 										"parameter_value": {
 											Type:         schema.TypeString,
@@ -188,7 +201,7 @@ func processingConfigurationSchema() *schema.Schema {
 							"type": {
 								Type:         schema.TypeString,
 								Required:     true,
-								ValidateFunc: validation.StringInSlice(firehose.ProcessorType_Values(), false),
+								ValidateFunc: validation.StringInSlice(tffirehose.ProcessorType_Values(), false),
 							},
 						},
 					},
@@ -629,12 +642,22 @@ func flattenProcessingConfiguration(pc *firehose.ProcessingConfiguration, roleAr
 		"BufferIntervalInSeconds": "60",
 	}
 
-	processors := make([]interface{}, len(pc.Processors))
-	for i, p := range pc.Processors {
+	// Processors and their parameters are kept in the order returned by the API,
+	// which matches the order in which they were configured.
+	processors := make([]interface{}, 0, len(pc.Processors))
+	for _, p := range pc.Processors {
+		if p == nil {
+			continue
+		}
+
 		t := aws.StringValue(p.Type)
 		parameters := make([]interface{}, 0)
 
 		for _, params := range p.Parameters {
+			if params == nil {
+				continue
+			}
+
 			name := aws.StringValue(params.ParameterName)
 			value := aws.StringValue(params.ParameterValue)
 
@@ -651,10 +674,10 @@ func flattenProcessingConfiguration(pc *firehose.ProcessingConfiguration, roleAr
 			})
 		}
 
-		processors[i] = map[string]interface{}{
+		processors = append(processors, map[string]interface{}{
 			"type":       t,
 			"parameters": parameters,
-		}
+		})
 	}
 	processingConfiguration[0] = map[string]interface{}{
 		"enabled":    aws.BoolValue(pc.Enabled),
@@ -701,10 +724,15 @@ func flattenKinesisFirehoseDeliveryStream(d *schema.ResourceData, s *firehose.De
 		return fmt.Errorf("error setting server_side_encryption: %s", err)
 	}
 
+	// Always set the source so that a stream whose source no longer matches the
+	// configuration is detected as drift and replaced.
+	var sourceDesc *firehose.KinesisStreamSourceDescription
 	if s.Source != nil {
-		if err := d.Set("kinesis_source_configuration", flattenFirehoseKinesisSourceConfiguration(s.Source.KinesisStreamSourceDescription)); err != nil {
-			return fmt.Errorf("error setting kinesis_source_configuration: %s", err)
-		}
+		sourceDesc = s.Source.KinesisStreamSourceDescription
+	}
+
+	if err := d.Set("kinesis_source_configuration", flattenFirehoseKinesisSourceConfiguration(sourceDesc)); err != nil {
+		return fmt.Errorf("error setting kinesis_source_configuration: %s", err)
 	}
 
 	if len(s.Destinations) > 0 {
@@ -811,7 +839,14 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceAwsKinesisFirehoseDeliveryStreamBufferingHintsCustomizeDiff,
+			resourceAwsKinesisFirehoseDeliveryStreamPrefixCustomizeDiff,
+			resourceAwsKinesisFirehoseDeliveryStreamDataFormatConversionCustomizeDiff,
+			resourceAwsKinesisFirehoseDeliveryStreamServerSideEncryptionCustomizeDiff,
+			resourceAwsKinesisFirehoseDeliveryStreamProcessorsCustomizeDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsKinesisFirehoseMigrateState,
@@ -1547,6 +1582,208 @@ func resourceAwsKinesisFirehoseDeliveryStream() *schema.Resource {
 	}
 }
 
+func resourceAwsKinesisFirehoseDeliveryStreamBufferingHintsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	destination := strings.ToLower(diff.Get("destination").(string))
+
+	// All destinations other than extended_s3 buffer into s3_configuration.
+	s3ConfigurationKey := "s3_configuration"
+	if destination == firehoseDestinationTypeExtendedS3 {
+		s3ConfigurationKey = "extended_s3_configuration"
+	}
+
+	if err := validateFirehoseBufferingHints(diff, destination, s3ConfigurationKey, "buffer_interval", "buffer_size", tffirehose.S3BufferingSizeInMBsMax); err != nil {
+		return err
+	}
+
+	switch destination {
+	case firehoseDestinationTypeElasticsearch:
+		return validateFirehoseBufferingHints(diff, destination, "elasticsearch_configuration", "buffering_interval", "buffering_size", tffirehose.ElasticsearchBufferingSizeInMBsMax)
+	case firehoseDestinationTypeHttpEndpoint:
+		return validateFirehoseBufferingHints(diff, destination, "http_endpoint_configuration", "buffering_interval", "buffering_size", tffirehose.HttpEndpointBufferingSizeInMBsMax)
+	}
+
+	return nil
+}
+
+// resourceAwsKinesisFirehoseDeliveryStreamPrefixCustomizeDiff rejects S3 prefixes that use
+// partition key expressions, which AWS only accepts when dynamic partitioning is enabled.
+// Dynamic partitioning is not supported by this resource, so it is always disabled.
+func resourceAwsKinesisFirehoseDeliveryStreamPrefixCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{
+		"s3_configuration.0.prefix",
+		"extended_s3_configuration.0.prefix",
+		"extended_s3_configuration.0.error_output_prefix",
+	} {
+		if !diff.NewValueKnown(k) {
+			continue
+		}
+
+		v, ok := diff.Get(k).(string)
+
+		if !ok {
+			continue
+		}
+
+		for _, expr := range []string{tffirehose.PrefixExpressionPartitionKeyFromQuery, tffirehose.PrefixExpressionPartitionKeyFromLambda} {
+			if strings.Contains(v, "!{"+expr+":") {
+				return fmt.Errorf("%s must not use !{%s:...} expressions when dynamic partitioning is disabled, got: %q", k, expr, v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceAwsKinesisFirehoseDeliveryStreamDataFormatConversionCustomizeDiff ensures that
+// enabled data format conversion references a Glue table and chooses exactly one serializer.
+func resourceAwsKinesisFirehoseDeliveryStreamDataFormatConversionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	const k = "extended_s3_configuration.0.data_format_conversion_configuration"
+
+	if !diff.NewValueKnown(k + ".0.enabled") {
+		return nil
+	}
+
+	if v, ok := diff.Get(k + ".0.enabled").(bool); !ok || !v {
+		return nil
+	}
+
+	if v, ok := diff.Get(k).([]interface{}); !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	for _, attr := range []string{"database_name", "role_arn", "table_name"} {
+		key := fmt.Sprintf("%s.0.schema_configuration.0.%s", k, attr)
+
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		if v := diff.Get(key).(string); v == "" {
+			return fmt.Errorf("%s must be set when %s.0.enabled is true", key, k)
+		}
+	}
+
+	serializerKey := k + ".0.output_format_configuration.0.serializer.0"
+	var serializers int
+
+	for _, attr := range []string{"orc_ser_de", "parquet_ser_de"} {
+		if v, ok := diff.Get(serializerKey + "." + attr).([]interface{}); ok && len(v) > 0 {
+			serializers++
+		}
+	}
+
+	if serializers != 1 {
+		return fmt.Errorf("exactly one of %[1]s.orc_ser_de or %[1]s.parquet_ser_de must be set when %[2]s.0.enabled is true", serializerKey, k)
+	}
+
+	return nil
+}
+
+// resourceAwsKinesisFirehoseDeliveryStreamProcessorsCustomizeDiff rejects processor
+// parameters that the Decompression and CloudWatchLogProcessing processors do not accept,
+// and CloudWatch Logs message extraction without a Decompression processor.
+func resourceAwsKinesisFirehoseDeliveryStreamProcessorsCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range firehoseProcessingConfigurationKeys {
+		key := k + ".0.processing_configuration.0.processors"
+		processors, ok := diff.Get(key).([]interface{})
+
+		if !ok || !firehoseProcessorsKnown(diff, key, processors) {
+			continue
+		}
+
+		if err := validateFirehoseProcessorParameters(processors); err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+
+		if err := validateFirehoseProcessorMessageExtraction(processors); err != nil {
+			return fmt.Errorf("%s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// firehoseProcessorsKnown returns whether the processor types and parameters under key
+// are known, as they may reference resources that are created in the same configuration.
+func firehoseProcessorsKnown(diff *schema.ResourceDiff, key string, processors []interface{}) bool {
+	for i, processor := range processors {
+		tfMap, ok := processor.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if !diff.NewValueKnown(fmt.Sprintf("%s.%d.type", key, i)) {
+			return false
+		}
+
+		for j := range tfMap["parameters"].([]interface{}) {
+			for _, attr := range []string{"parameter_name", "parameter_value"} {
+				if !diff.NewValueKnown(fmt.Sprintf("%s.%d.parameters.%d.%s", key, i, j, attr)) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// resourceAwsKinesisFirehoseDeliveryStreamServerSideEncryptionCustomizeDiff checks that
+// key_arn is set exactly when key_type is CUSTOMER_MANAGED_CMK, so mistakes fail at plan.
+func resourceAwsKinesisFirehoseDeliveryStreamServerSideEncryptionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("server_side_encryption.0.enabled") || !diff.NewValueKnown("server_side_encryption.0.key_type") {
+		return nil
+	}
+
+	tfList := diff.Get("server_side_encryption").([]interface{})
+
+	// key_arn commonly references a KMS key created in the same configuration. Its value
+	// is not known until apply, but it will be set, which is all the check depends on.
+	if len(tfList) > 0 && tfList[0] != nil && !diff.NewValueKnown("server_side_encryption.0.key_arn") {
+		tfMap := map[string]interface{}{}
+
+		for k, v := range tfList[0].(map[string]interface{}) {
+			tfMap[k] = v
+		}
+
+		tfMap["key_arn"] = "(known after apply)"
+		tfList = []interface{}{tfMap}
+	}
+
+	if err := validateFirehoseServerSideEncryption(tfList); err != nil {
+		return fmt.Errorf("server_side_encryption: %w", err)
+	}
+
+	return nil
+}
+
+func validateFirehoseBufferingHints(diff *schema.ResourceDiff, destination, configurationKey, intervalKey, sizeKey string, maxSizeInMBs int) error {
+	if v, ok := diff.Get(configurationKey).([]interface{}); !ok || len(v) == 0 || v[0] == nil {
+		return nil
+	}
+
+	for _, limit := range []struct {
+		key      string
+		min, max int
+	}{
+		{intervalKey, tffirehose.BufferingIntervalInSecondsMin, tffirehose.BufferingIntervalInSecondsMax},
+		{sizeKey, tffirehose.BufferingSizeInMBsMin, maxSizeInMBs},
+	} {
+		k := fmt.Sprintf("%s.0.%s", configurationKey, limit.key)
+
+		if !diff.NewValueKnown(k) {
+			continue
+		}
+
+		if v := diff.Get(k).(int); v < limit.min || v > limit.max {
+			return fmt.Errorf("%s must be between %d and %d for %s destination, got: %d", k, limit.min, limit.max, destination, v)
+		}
+	}
+
+	return nil
+}
+
 func createSourceConfig(source map[string]interface{}) *firehose.KinesisStreamSourceConfiguration {
 
 	configuration := &firehose.KinesisStreamSourceConfiguration{
@@ -2482,6 +2719,11 @@ func resourceAwsKinesisFirehoseDeliveryStreamCreate(d *schema.ResourceData, meta
 		createInput.DeliveryStreamType = aws.String(firehose.DeliveryStreamTypeDirectPut)
 	}
 
+	// Enable server-side encryption as part of creation so that the delivery stream never accepts unencrypted data.
+	if v, ok := d.GetOk("server_side_encryption"); ok && !isKinesisFirehoseDeliveryStreamOptionDisabled(v) {
+		createInput.DeliveryStreamEncryptionConfigurationInput = expandFirehoseDeliveryStreamEncryptionConfigurationInput(v.([]interface{}))
+	}
+
 	if d.Get("destination").(string) == firehoseDestinationTypeExtendedS3 {
 		extendedS3Config := createExtendedS3Config(d)
 		createInput.ExtendedS3DestinationConfiguration = extendedS3Config
@@ -2517,12 +2759,68 @@ func resourceAwsKinesisFirehoseDeliveryStreamCreate(d *schema.ResourceData, meta
 		}
 	}
 
+	// Tag on create so that the stream is never untagged, which matters for tag-based IAM policies.
 	if len(tags) > 0 {
 		createInput.Tags = tags.IgnoreAws().FirehoseTags()
 	}
 
+	tagAfterCreate, err := createKinesisFirehoseDeliveryStreamWithTags(conn, createInput)
+
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Firehose Delivery Stream: %s", err)
+	}
+
+	s, err := waiter.DeliveryStreamCreated(conn, sn)
+
+	if err != nil {
+		return fmt.Errorf("error waiting for Kinesis Firehose Delivery Stream (%s) create: %w", sn, err)
+	}
+
+	d.SetId(aws.StringValue(s.DeliveryStreamARN))
+	d.Set("arn", s.DeliveryStreamARN)
+
+	if tagAfterCreate {
+		if err := keyvaluetags.FirehoseUpdateTags(conn, sn, nil, tags.IgnoreAws()); err != nil {
+			return fmt.Errorf("error adding Kinesis Firehose Delivery Stream (%s) tags: %w", sn, err)
+		}
+	}
+
+	if createInput.DeliveryStreamEncryptionConfigurationInput != nil {
+		// Terraform taints the delivery stream so that it is replaced on the next apply.
+		if _, err := waiter.DeliveryStreamEncryptionEnabled(conn, sn); err != nil {
+			return fmt.Errorf("error waiting for Kinesis Firehose Delivery Stream (%s) encryption enable, delivery stream is not encrypted: %w", sn, err)
+		}
+	}
+
+	return resourceAwsKinesisFirehoseDeliveryStreamRead(d, meta)
+}
+
+// createKinesisFirehoseDeliveryStreamWithTags creates the delivery stream with its tags.
+// Callers may be allowed to create delivery streams but not to tag them, in which case the
+// delivery stream is created without tags and true is returned to indicate that the tags
+// must be applied once the delivery stream exists.
+func createKinesisFirehoseDeliveryStreamWithTags(conn *firehose.Firehose, input *firehose.CreateDeliveryStreamInput) (bool, error) {
+	err := createKinesisFirehoseDeliveryStream(conn, input)
+
+	if input.Tags == nil || !tfawserr.ErrCodeEquals(err, tffirehose.ErrCodeAccessDeniedException) {
+		return false, err
+	}
+
+	log.Printf("[WARN] error creating Kinesis Firehose Delivery Stream (%s) with tags: %s. Retrying without tags.", aws.StringValue(input.DeliveryStreamName), err)
+
+	input.Tags = nil
+
+	if err := createKinesisFirehoseDeliveryStream(conn, input); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// createKinesisFirehoseDeliveryStream creates the delivery stream, retrying while IAM changes propagate.
+func createKinesisFirehoseDeliveryStream(conn *firehose.Firehose, input *firehose.CreateDeliveryStreamInput) error {
 	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateDeliveryStream(createInput)
+		_, err := conn.CreateDeliveryStream(input)
 		if err != nil {
 			// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
 			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Access was denied") {
@@ -2552,39 +2850,10 @@ func resourceAwsKinesisFirehoseDeliveryStreamCreate(d *schema.ResourceData, meta
 		return nil
 	})
 	if isResourceTimeoutError(err) {
-		_, err = conn.CreateDeliveryStream(createInput)
-	}
-	if err != nil {
-		return fmt.Errorf("error creating Kinesis Firehose Delivery Stream: %s", err)
+		_, err = conn.CreateDeliveryStream(input)
 	}
 
-	s, err := waiter.DeliveryStreamCreated(conn, sn)
-
-	if err != nil {
-		return fmt.Errorf("error waiting for Kinesis Firehose Delivery Stream (%s) create: %w", sn, err)
-	}
-
-	d.SetId(aws.StringValue(s.DeliveryStreamARN))
-	d.Set("arn", s.DeliveryStreamARN)
-
-	if v, ok := d.GetOk("server_side_encryption"); ok && !isKinesisFirehoseDeliveryStreamOptionDisabled(v) {
-		startInput := &firehose.StartDeliveryStreamEncryptionInput{
-			DeliveryStreamName:                         aws.String(sn),
-			DeliveryStreamEncryptionConfigurationInput: expandFirehoseDeliveryStreamEncryptionConfigurationInput(v.([]interface{})),
-		}
-
-		_, err := conn.StartDeliveryStreamEncryption(startInput)
-
-		if err != nil {
-			return fmt.Errorf("error starting Kinesis Firehose Delivery Stream (%s) encryption: %w", sn, err)
-		}
-
-		if _, err := waiter.DeliveryStreamEncryptionEnabled(conn, sn); err != nil {
-			return fmt.Errorf("error waiting for Kinesis Firehose Delivery Stream (%s) encryption enable: %w", sn, err)
-		}
-	}
-
-	return resourceAwsKinesisFirehoseDeliveryStreamRead(d, meta)
+	return err
 }
 
 func validateAwsKinesisFirehoseSchema(d *schema.ResourceData) error {
@@ -2618,6 +2887,121 @@ func validateAwsKinesisFirehoseSchema(d *schema.ResourceData) error {
 	return nil
 }
 
+func validateFirehoseServerSideEncryption(tfList []interface{}) error {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if !tfMap["enabled"].(bool) {
+		return nil
+	}
+
+	keyType := tfMap["key_type"].(string)
+	keyArn := tfMap["key_arn"].(string)
+
+	if keyType == firehose.KeyTypeCustomerManagedCmk && keyArn == "" {
+		return fmt.Errorf("key_arn is required when key_type is %q", firehose.KeyTypeCustomerManagedCmk)
+	}
+
+	if keyType != firehose.KeyTypeCustomerManagedCmk && keyArn != "" {
+		return fmt.Errorf("key_arn can only be set when key_type is %q, got key_type %q", firehose.KeyTypeCustomerManagedCmk, keyType)
+	}
+
+	return nil
+}
+
+func validateFirehoseProcessorParameters(processors []interface{}) error {
+	parameterNamesByType := tffirehose.ProcessorParameterNamesByType()
+
+	for _, processor := range processors {
+		tfMap, ok := processor.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		processorType := tfMap["type"].(string)
+		allowed, ok := parameterNamesByType[processorType]
+
+		if !ok {
+			continue
+		}
+
+		for _, parameter := range tfMap["parameters"].([]interface{}) {
+			tfMap, ok := parameter.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			name := tfMap["parameter_name"].(string)
+			supported := false
+
+			for _, v := range allowed {
+				if v == name {
+					supported = true
+					break
+				}
+			}
+
+			if !supported {
+				return fmt.Errorf("processor type %q does not support parameter %q, expected one of %q", processorType, name, allowed)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateFirehoseProcessorMessageExtraction(processors []interface{}) error {
+	decompression := false
+	messageExtraction := false
+
+	for _, processor := range processors {
+		tfMap, ok := processor.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		processorType := tfMap["type"].(string)
+
+		if processorType == tffirehose.ProcessorTypeDecompression {
+			decompression = true
+		}
+
+		if processorType != tffirehose.ProcessorTypeCloudWatchLogProcessing {
+			continue
+		}
+
+		for _, parameter := range tfMap["parameters"].([]interface{}) {
+			tfMap, ok := parameter.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if tfMap["parameter_name"].(string) != tffirehose.ProcessorParameterNameDataMessageExtraction {
+				continue
+			}
+
+			if v, ok := tfMap["parameter_value"].(string); ok && strings.EqualFold(v, "true") {
+				messageExtraction = true
+			}
+		}
+	}
+
+	// Extracted CloudWatch Logs messages are emitted as individual records,
+	// which is only possible once the subscription payload has been decompressed.
+	if messageExtraction && !decompression {
+		return fmt.Errorf("processor type %q parameter %q requires a %q processor", tffirehose.ProcessorTypeCloudWatchLogProcessing, tffirehose.ProcessorParameterNameDataMessageExtraction, tffirehose.ProcessorTypeDecompression)
+	}
+
+	return nil
+}
+
 func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta interface{}) error {
 	validateError := validateAwsKinesisFirehoseSchema(d)
 
@@ -2669,40 +3053,7 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
-		_, err := conn.UpdateDestination(updateInput)
-		if err != nil {
-			// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Access was denied") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "is not authorized to") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Please make sure the role specified in VpcConfiguration has permissions") {
-				return resource.RetryableError(err)
-			}
-
-			// InvalidArgumentException: Verify that the IAM role has access to the ElasticSearch domain.
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Verify that the IAM role has access") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Firehose is unable to assume role") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if isResourceTimeoutError(err) {
-		_, err = conn.UpdateDestination(updateInput)
-	}
+	err := updateKinesisFirehoseDeliveryStreamDestination(conn, updateInput)
 
 	if err != nil {
 		return fmt.Errorf(
@@ -2754,6 +3105,61 @@ func resourceAwsKinesisFirehoseDeliveryStreamUpdate(d *schema.ResourceData, meta
 	return resourceAwsKinesisFirehoseDeliveryStreamRead(d, meta)
 }
 
+// updateKinesisFirehoseDeliveryStreamDestination calls UpdateDestination, retrying on
+// IAM eventual consistency errors and on concurrent modification of the delivery stream.
+func updateKinesisFirehoseDeliveryStreamDestination(conn *firehose.Firehose, input *firehose.UpdateDestinationInput) error {
+	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.UpdateDestination(input)
+		if err != nil {
+			// The delivery stream was modified since the version ID was read.
+			// Retry with the latest version ID.
+			if tfawserr.ErrCodeEquals(err, firehose.ErrCodeConcurrentModificationException) {
+				output, describeErr := finder.DeliveryStreamByName(conn, aws.StringValue(input.DeliveryStreamName))
+
+				if describeErr != nil {
+					return resource.NonRetryableError(fmt.Errorf("error reading Kinesis Firehose Delivery Stream (%s) after concurrent modification: %w", aws.StringValue(input.DeliveryStreamName), describeErr))
+				}
+
+				input.CurrentDeliveryStreamVersionId = output.VersionId
+
+				return resource.RetryableError(err)
+			}
+
+			// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
+			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Access was denied") {
+				return resource.RetryableError(err)
+			}
+
+			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "is not authorized to") {
+				return resource.RetryableError(err)
+			}
+
+			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Please make sure the role specified in VpcConfiguration has permissions") {
+				return resource.RetryableError(err)
+			}
+
+			// InvalidArgumentException: Verify that the IAM role has access to the ElasticSearch domain.
+			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Verify that the IAM role has access") {
+				return resource.RetryableError(err)
+			}
+
+			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Firehose is unable to assume role") {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, err = conn.UpdateDestination(input)
+	}
+
+	return err
+}
+
 func resourceAwsKinesisFirehoseDeliveryStreamRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).firehoseconn
 	defaultTagsConfig := meta.(*AWSClient).DefaultTagsConfig
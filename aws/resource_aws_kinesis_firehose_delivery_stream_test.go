@@ -3,16 +3,22 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tffirehose "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/firehose"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/firehose/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
@@ -68,6 +74,173 @@ func testSweepKinesisFirehoseDeliveryStreams(region string) error {
 	return nil
 }
 
+func TestUpdateKinesisFirehoseDeliveryStreamDestination(t *testing.T) {
+	testCases := []struct {
+		name              string
+		updateErrs        []error
+		wantErr           bool
+		wantUpdateCalls   int
+		wantDescribeCalls int
+		wantVersionID     string
+	}{
+		{
+			name:            "success",
+			wantUpdateCalls: 1,
+			wantVersionID:   "1",
+		},
+		{
+			name:              "concurrent modification",
+			updateErrs:        []error{awserr.New(firehose.ErrCodeConcurrentModificationException, "Cannot update a delivery stream while it is being modified", nil)},
+			wantUpdateCalls:   2,
+			wantDescribeCalls: 1,
+			wantVersionID:     "2",
+		},
+		{
+			name:            "other error",
+			updateErrs:      []error{awserr.New(firehose.ErrCodeResourceInUseException, "Delivery stream is not in ACTIVE state", nil)},
+			wantErr:         true,
+			wantUpdateCalls: 1,
+			wantVersionID:   "1",
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := firehose.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var updateCalls, describeCalls int
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch data := r.Data.(type) {
+				case *firehose.DescribeDeliveryStreamOutput:
+					describeCalls++
+					data.DeliveryStreamDescription = &firehose.DeliveryStreamDescription{
+						DeliveryStreamName: aws.String("test"),
+						VersionId:          aws.String("2"),
+					}
+				case *firehose.UpdateDestinationOutput:
+					if updateCalls < len(testCase.updateErrs) {
+						r.Error = testCase.updateErrs[updateCalls]
+					}
+					updateCalls++
+				}
+			})
+
+			input := &firehose.UpdateDestinationInput{
+				CurrentDeliveryStreamVersionId: aws.String("1"),
+				DeliveryStreamName:             aws.String("test"),
+				DestinationId:                  aws.String("destinationId-000000000001"),
+			}
+
+			err := updateKinesisFirehoseDeliveryStreamDestination(conn, input)
+
+			if testCase.wantErr && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if updateCalls != testCase.wantUpdateCalls {
+				t.Errorf("expected %d UpdateDestination calls, got %d", testCase.wantUpdateCalls, updateCalls)
+			}
+
+			if describeCalls != testCase.wantDescribeCalls {
+				t.Errorf("expected %d DescribeDeliveryStream calls, got %d", testCase.wantDescribeCalls, describeCalls)
+			}
+
+			if got := aws.StringValue(input.CurrentDeliveryStreamVersionId); got != testCase.wantVersionID {
+				t.Errorf("expected version ID %s, got %s", testCase.wantVersionID, got)
+			}
+		})
+	}
+}
+
+func TestCreateKinesisFirehoseDeliveryStreamWithTags(t *testing.T) {
+	testCases := []struct {
+		name               string
+		tags               []*firehose.Tag
+		createErrs         []error
+		wantErr            bool
+		wantTagAfterCreate bool
+		wantCreateTags     []int
+	}{
+		{
+			name:           "tags on create",
+			tags:           []*firehose.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+			wantCreateTags: []int{1},
+		},
+		{
+			name:               "tagging denied",
+			tags:               []*firehose.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+			createErrs:         []error{awserr.New(tffirehose.ErrCodeAccessDeniedException, "not authorized to perform: firehose:TagDeliveryStream", nil)},
+			wantTagAfterCreate: true,
+			wantCreateTags:     []int{1, 0},
+		},
+		{
+			name:           "access denied without tags",
+			createErrs:     []error{awserr.New(tffirehose.ErrCodeAccessDeniedException, "not authorized to perform: firehose:CreateDeliveryStream", nil)},
+			wantErr:        true,
+			wantCreateTags: []int{0},
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := firehose.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var createTags []int
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if params, ok := r.Params.(*firehose.CreateDeliveryStreamInput); ok {
+					if calls := len(createTags); calls < len(testCase.createErrs) {
+						r.Error = testCase.createErrs[calls]
+					}
+					createTags = append(createTags, len(params.Tags))
+				}
+			})
+
+			input := &firehose.CreateDeliveryStreamInput{
+				DeliveryStreamName: aws.String("test"),
+				Tags:               testCase.tags,
+			}
+
+			tagAfterCreate, err := createKinesisFirehoseDeliveryStreamWithTags(conn, input)
+
+			if testCase.wantErr && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if tagAfterCreate != testCase.wantTagAfterCreate {
+				t.Errorf("expected tag after create %t, got %t", testCase.wantTagAfterCreate, tagAfterCreate)
+			}
+
+			if !reflect.DeepEqual(createTags, testCase.wantCreateTags) {
+				t.Errorf("expected CreateDeliveryStream tag counts %v, got %v", testCase.wantCreateTags, createTags)
+			}
+		})
+	}
+}
+
 func TestAccAWSKinesisFirehoseDeliveryStream_basic(t *testing.T) {
 	resourceName := "aws_kinesis_firehose_delivery_stream.test"
 	rInt := acctest.RandInt()
@@ -171,6 +344,36 @@ func TestAccAWSKinesisFirehoseDeliveryStream_s3basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSKinesisFirehoseDeliveryStream_Destination_ForceNew(t *testing.T) {
+	var before, after firehose.DeliveryStreamDescription
+	rInt := acctest.RandInt()
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisFirehoseDeliveryStreamConfig_Destination(rInt, firehoseDestinationTypeS3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisFirehoseDeliveryStreamExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "destination", firehoseDestinationTypeS3),
+				),
+			},
+			{
+				Config: testAccKinesisFirehoseDeliveryStreamConfig_Destination(rInt, firehoseDestinationTypeExtendedS3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisFirehoseDeliveryStreamExists(resourceName, &after),
+					testAccCheckKinesisFirehoseDeliveryStreamRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "destination", firehoseDestinationTypeExtendedS3),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSKinesisFirehoseDeliveryStream_s3basicWithSSE(t *testing.T) {
 	var stream firehose.DeliveryStreamDescription
 	rInt := acctest.RandInt()
@@ -241,6 +444,7 @@ func TestAccAWSKinesisFirehoseDeliveryStream_s3basicWithSSEAndKeyArn(t *testing.
 					testAccCheckAWSKinesisFirehoseDeliveryStreamAttributes(&stream, nil, nil, nil, nil, nil, nil),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.0.enabled", "true"),
+					testAccCheckKinesisFirehoseDeliveryStreamEncryptionStatus(&stream, firehose.DeliveryStreamEncryptionStatusEnabled),
 					resource.TestCheckResourceAttr(resourceName, "server_side_encryption.0.key_type", firehose.KeyTypeCustomerManagedCmk),
 					resource.TestCheckResourceAttrPair(resourceName, "server_side_encryption.0.key_arn", "aws_kms_key.test", "arn"),
 				),
@@ -269,6 +473,30 @@ func TestAccAWSKinesisFirehoseDeliveryStream_s3basicWithSSEAndKeyArn(t *testing.
 	})
 }
 
+func TestAccAWSKinesisFirehoseDeliveryStream_s3basicWithSSE_InvalidKeyArn(t *testing.T) {
+	rInt := acctest.RandInt()
+	rName := fmt.Sprintf("terraform-kinesis-firehose-basictest-%d", rInt)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_s3basicWithSSEAndKeyType(rName, rInt, true, firehose.KeyTypeCustomerManagedCmk),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`server_side_encryption: key_arn is required when key_type is "CUSTOMER_MANAGED_CMK"`),
+			},
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_s3basicWithSSEAndKeyArnAndKeyType(rName, rInt, firehose.KeyTypeAwsOwnedCmk),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`server_side_encryption: key_arn can only be set when key_type is "CUSTOMER_MANAGED_CMK"`),
+			},
+		},
+	})
+}
+
 func TestAccAWSKinesisFirehoseDeliveryStream_s3basicWithSSEAndKeyType(t *testing.T) {
 	var stream firehose.DeliveryStreamDescription
 	rInt := acctest.RandInt()
@@ -314,6 +542,199 @@ func TestAccAWSKinesisFirehoseDeliveryStream_s3basicWithSSEAndKeyType(t *testing
 	})
 }
 
+func TestValidateFirehoseServerSideEncryption(t *testing.T) {
+	keyArn := "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012" //lintignore:AWSAT003,AWSAT005
+
+	testCases := []struct {
+		name        string
+		tfList      []interface{}
+		expectError bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "disabled with customer managed key and no arn",
+			tfList: []interface{}{map[string]interface{}{
+				"enabled":  false,
+				"key_type": firehose.KeyTypeCustomerManagedCmk,
+				"key_arn":  "",
+			}},
+		},
+		{
+			name: "aws owned key",
+			tfList: []interface{}{map[string]interface{}{
+				"enabled":  true,
+				"key_type": firehose.KeyTypeAwsOwnedCmk,
+				"key_arn":  "",
+			}},
+		},
+		{
+			name: "customer managed key",
+			tfList: []interface{}{map[string]interface{}{
+				"enabled":  true,
+				"key_type": firehose.KeyTypeCustomerManagedCmk,
+				"key_arn":  keyArn,
+			}},
+		},
+		{
+			name: "customer managed key missing arn",
+			tfList: []interface{}{map[string]interface{}{
+				"enabled":  true,
+				"key_type": firehose.KeyTypeCustomerManagedCmk,
+				"key_arn":  "",
+			}},
+			expectError: true,
+		},
+		{
+			name: "aws owned key with unexpected arn",
+			tfList: []interface{}{map[string]interface{}{
+				"enabled":  true,
+				"key_type": firehose.KeyTypeAwsOwnedCmk,
+				"key_arn":  keyArn,
+			}},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateFirehoseServerSideEncryption(testCase.tfList)
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateFirehoseProcessorParameters(t *testing.T) {
+	processor := func(processorType, parameterName, parameterValue string) interface{} {
+		return map[string]interface{}{
+			"type": processorType,
+			"parameters": []interface{}{
+				map[string]interface{}{
+					"parameter_name":  parameterName,
+					"parameter_value": parameterValue,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		processors  []interface{}
+		expectError bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "lambda",
+			processors: []interface{}{
+				processor("Lambda", "LambdaArn", "arn:aws:lambda:us-west-2:123456789012:function:test:$LATEST"),
+			},
+		},
+		{
+			name: "decompression with message extraction",
+			processors: []interface{}{
+				processor("Decompression", "CompressionFormat", "GZIP"),
+				processor("CloudWatchLogProcessing", "DataMessageExtraction", "true"),
+			},
+		},
+		{
+			name: "unsupported parameter",
+			processors: []interface{}{
+				processor("Decompression", "Delimiter", "GZIP"),
+			},
+			expectError: true,
+		},
+		{
+			name: "unsupported parameter for message extraction",
+			processors: []interface{}{
+				processor("CloudWatchLogProcessing", "CompressionFormat", "GZIP"),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateFirehoseProcessorParameters(testCase.processors)
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateFirehoseProcessorMessageExtraction(t *testing.T) {
+	processor := func(processorType, parameterName, parameterValue string) interface{} {
+		return map[string]interface{}{
+			"type": processorType,
+			"parameters": []interface{}{
+				map[string]interface{}{
+					"parameter_name":  parameterName,
+					"parameter_value": parameterValue,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		processors  []interface{}
+		expectError bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "decompression with message extraction",
+			processors: []interface{}{
+				processor("Decompression", "CompressionFormat", "GZIP"),
+				processor("CloudWatchLogProcessing", "DataMessageExtraction", "true"),
+			},
+		},
+		{
+			name: "message extraction without decompression",
+			processors: []interface{}{
+				processor("CloudWatchLogProcessing", "DataMessageExtraction", "true"),
+			},
+			expectError: true,
+		},
+		{
+			name: "message extraction disabled without decompression",
+			processors: []interface{}{
+				processor("CloudWatchLogProcessing", "DataMessageExtraction", "false"),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateFirehoseProcessorMessageExtraction(testCase.processors)
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccAWSKinesisFirehoseDeliveryStream_s3basicWithTags(t *testing.T) {
 	var stream firehose.DeliveryStreamDescription
 	rInt := acctest.RandInt()
@@ -333,6 +754,7 @@ func TestAccAWSKinesisFirehoseDeliveryStream_s3basicWithTags(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKinesisFirehoseDeliveryStreamExists(resourceName, &stream),
 					testAccCheckAWSKinesisFirehoseDeliveryStreamAttributes(&stream, nil, nil, nil, nil, nil, nil),
+					testAccCheckKinesisFirehoseDeliveryStreamTag(rName, "Usage", "original"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Usage", "original"),
 				),
@@ -376,12 +798,139 @@ func TestAccAWSKinesisFirehoseDeliveryStream_s3KinesisStreamSource(t *testing.T)
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckKinesisFirehoseDeliveryStreamExists(resourceName, &stream),
 					testAccCheckAWSKinesisFirehoseDeliveryStreamAttributes(&stream, nil, nil, nil, nil, nil, nil),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_source_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_source_configuration.0.kinesis_stream_arn", "aws_kinesis_stream.source", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_source_configuration.0.role_arn", "aws_iam_role.kinesis_source", "arn"),
 				),
 			},
+			{
+				// The source is read back from the API rather than copied from configuration.
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestFlattenFirehoseKinesisSourceConfiguration(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *firehose.KinesisStreamSourceDescription
+		Expected []interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "stream and role",
+			Input: &firehose.KinesisStreamSourceDescription{
+				KinesisStreamARN: aws.String("arn:aws:kinesis:us-west-2:123456789012:stream/source"),
+				RoleARN:          aws.String("arn:aws:iam::123456789012:role/source"),
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"kinesis_stream_arn": "arn:aws:kinesis:us-west-2:123456789012:stream/source",
+					"role_arn":           "arn:aws:iam::123456789012:role/source",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenFirehoseKinesisSourceConfiguration(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenProcessingConfiguration(t *testing.T) {
+	roleARN := "arn:aws:iam::123456789012:role/firehose"
+
+	input := &firehose.ProcessingConfiguration{
+		Enabled: aws.Bool(true),
+		Processors: []*firehose.Processor{
+			{
+				Type: aws.String(tffirehose.ProcessorTypeDecompression),
+				Parameters: []*firehose.ProcessorParameter{
+					{
+						ParameterName:  aws.String(tffirehose.ProcessorParameterNameCompressionFormat),
+						ParameterValue: aws.String("GZIP"),
+					},
+				},
+			},
+			nil,
+			{
+				Type: aws.String(firehose.ProcessorTypeLambda),
+				Parameters: []*firehose.ProcessorParameter{
+					{
+						ParameterName:  aws.String(firehose.ProcessorParameterNameLambdaArn),
+						ParameterValue: aws.String("arn:aws:lambda:us-west-2:123456789012:function:test"),
+					},
+					{
+						ParameterName:  aws.String(firehose.ProcessorParameterNameRoleArn),
+						ParameterValue: aws.String(roleARN),
+					},
+					{
+						ParameterName:  aws.String(firehose.ProcessorParameterNameBufferSizeInMbs),
+						ParameterValue: aws.String("1"),
+					},
+					{
+						ParameterName:  aws.String(firehose.ProcessorParameterNameNumberOfRetries),
+						ParameterValue: aws.String("5"),
+					},
+				},
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"enabled": true,
+			"processors": []interface{}{
+				map[string]interface{}{
+					"type": tffirehose.ProcessorTypeDecompression,
+					"parameters": []interface{}{
+						map[string]interface{}{
+							"parameter_name":  tffirehose.ProcessorParameterNameCompressionFormat,
+							"parameter_value": "GZIP",
+						},
+					},
+				},
+				map[string]interface{}{
+					"type": firehose.ProcessorTypeLambda,
+					"parameters": []interface{}{
+						map[string]interface{}{
+							"parameter_name":  firehose.ProcessorParameterNameLambdaArn,
+							"parameter_value": "arn:aws:lambda:us-west-2:123456789012:function:test",
+						},
+						map[string]interface{}{
+							"parameter_name":  firehose.ProcessorParameterNameBufferSizeInMbs,
+							"parameter_value": "1",
+						},
+						map[string]interface{}{
+							"parameter_name":  firehose.ProcessorParameterNameNumberOfRetries,
+							"parameter_value": "5",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := flattenProcessingConfiguration(input, roleARN)
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
+
 func TestAccAWSKinesisFirehoseDeliveryStream_s3WithCloudwatchLogging(t *testing.T) {
 	var stream firehose.DeliveryStreamDescription
 	ri := acctest.RandInt()
@@ -590,6 +1139,61 @@ func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ExternalUpdate(t *testin
 	})
 }
 
+func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ExternalUpdate_VersionId(t *testing.T) {
+	var stream firehose.DeliveryStreamDescription
+	rInt := acctest.RandInt()
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy_ExtendedS3,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_BufferingHints(rName, rInt, 300, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisFirehoseDeliveryStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.buffer_interval", "300"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+					input := &firehose.UpdateDestinationInput{
+						DeliveryStreamName:             aws.String(rName),
+						DestinationId:                  stream.Destinations[0].DestinationId,
+						CurrentDeliveryStreamVersionId: stream.VersionId,
+						ExtendedS3DestinationUpdate: &firehose.ExtendedS3DestinationUpdate{
+							BufferingHints: &firehose.BufferingHints{
+								IntervalInSeconds: aws.Int64(120),
+								SizeInMBs:         aws.Int64(5),
+							},
+						},
+					}
+
+					if _, err := conn.UpdateDestination(input); err != nil {
+						t.Fatalf("error updating Kinesis Firehose Delivery Stream (%s) destination: %s", rName, err)
+					}
+				},
+				Config:             testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_BufferingHints(rName, rInt, 300, 5),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_BufferingHints(rName, rInt, 300, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisFirehoseDeliveryStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "3"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.buffer_interval", "300"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_DataFormatConversionConfiguration_Deserializer_Update(t *testing.T) {
 	var stream firehose.DeliveryStreamDescription
 	rInt := acctest.RandInt()
@@ -761,6 +1365,46 @@ func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_DataFormatConversionConf
 	})
 }
 
+func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_DataFormatConversionConfiguration_SchemaConfiguration_Missing(t *testing.T) {
+	rInt := acctest.RandInt()
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy_ExtendedS3,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_DataFormatConversionConfiguration_Invalid(rName, rInt, "", "parquet_ser_de {}"),
+				ExpectError: regexp.MustCompile(`schema_configuration.0.database_name must be set`),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_DataFormatConversionConfiguration_Serializer_Invalid(t *testing.T) {
+	rInt := acctest.RandInt()
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy_ExtendedS3,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_DataFormatConversionConfiguration_Invalid(rName, rInt, rName, ""),
+				ExpectError: regexp.MustCompile(`exactly one of .*orc_ser_de or .*parquet_ser_de must be set`),
+			},
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_DataFormatConversionConfiguration_Invalid(rName, rInt, rName, "orc_ser_de {}\n          parquet_ser_de {}"),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
 func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_DataFormatConversionConfiguration_Serializer_Update(t *testing.T) {
 	var stream firehose.DeliveryStreamDescription
 	rInt := acctest.RandInt()
@@ -863,9 +1507,132 @@ func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ProcessingConfiguration_
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ProcessingConfiguration_Decompression(t *testing.T) {
+	var stream firehose.DeliveryStreamDescription
+	rInt := acctest.RandInt()
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_firehose_delivery_stream.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy_ExtendedS3,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_ProcessingConfiguration_Decompression(rName, rInt, "CompressionFormat"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisFirehoseDeliveryStreamExists(resourceName, &stream),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.0.processors.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.0.processors.0.type", "Decompression"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.0.processors.0.parameters.0.parameter_name", "CompressionFormat"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.0.processors.0.parameters.0.parameter_value", "GZIP"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.0.processors.1.type", "CloudWatchLogProcessing"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.0.processors.1.parameters.0.parameter_name", "DataMessageExtraction"),
+					resource.TestCheckResourceAttr(resourceName, "extended_s3_configuration.0.processing_configuration.0.processors.1.parameters.0.parameter_value", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_ProcessingConfiguration_Decompression(rName, rInt, "CompressionFormat"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ProcessingConfiguration_Decompression_InvalidParameter(t *testing.T) {
+	rInt := acctest.RandInt()
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy_ExtendedS3,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_ProcessingConfiguration_Decompression(rName, rInt, "Delimiter"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`processor type "Decompression" does not support parameter "Delimiter"`),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_ProcessingConfiguration_MessageExtraction_WithoutDecompression(t *testing.T) {
+	rInt := acctest.RandInt()
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy_ExtendedS3,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_ProcessingConfiguration_MessageExtraction(rName, rInt),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`processor type "CloudWatchLogProcessing" parameter "DataMessageExtraction" requires a "Decompression" processor`),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_BufferingHints_Invalid(t *testing.T) {
+	rInt := acctest.RandInt()
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy_ExtendedS3,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_BufferingHints(rName, rInt, 901, 5),
+				ExpectError: regexp.MustCompile(`extended_s3_configuration.0.buffer_interval must be between 60 and 900 for extended_s3 destination`),
+			},
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_BufferingHints(rName, rInt, 300, 129),
+				ExpectError: regexp.MustCompile(`extended_s3_configuration.0.buffer_size must be between 1 and 128 for extended_s3 destination`),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisFirehoseDeliveryStream_ExtendedS3_Prefix_PartitionKeyInvalid(t *testing.T) {
+	rInt := acctest.RandInt()
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy_ExtendedS3,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_Prefixes(rName, rInt, "data/!{partitionKeyFromQuery:customer_id}/", "errors/"),
+				ExpectError: regexp.MustCompile(`extended_s3_configuration.0.prefix must not use !\{partitionKeyFromQuery:...\} expressions when dynamic partitioning is disabled`),
+			},
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_Prefixes(rName, rInt, "data/", "errors/!{partitionKeyFromLambda:customer_id}/"),
+				ExpectError: regexp.MustCompile(`extended_s3_configuration.0.error_output_prefix must not use !\{partitionKeyFromLambda:...\} expressions when dynamic partitioning is disabled`),
 			},
 		},
 	})
@@ -1311,6 +2078,51 @@ func TestAccAWSKinesisFirehoseDeliveryStream_ElasticsearchConfigUpdates(t *testi
 	})
 }
 
+func TestAccAWSKinesisFirehoseDeliveryStream_Elasticsearch_BufferingHints_Invalid(t *testing.T) {
+	ri := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Valid for S3 destinations, but above the Elasticsearch limit.
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_Elasticsearch_BufferingSize(ri, 5, 128),
+				ExpectError: regexp.MustCompile(`elasticsearch_configuration.0.buffering_size`),
+			},
+			{
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_Elasticsearch_BufferingSize(ri, 129, 5),
+				ExpectError: regexp.MustCompile(`s3_configuration.0.buffer_size must be between 1 and 128 for elasticsearch destination`),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisFirehoseDeliveryStream_HTTPEndpoint_BufferingHints_Invalid(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccKinesisFirehoseDeliveryStreamConfig_HTTPEndpoint_BufferingSize(rInt, 64),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Valid for Elasticsearch destinations, but above the HTTP endpoint limit.
+				Config:      testAccKinesisFirehoseDeliveryStreamConfig_HTTPEndpoint_BufferingSize(rInt, 65),
+				ExpectError: regexp.MustCompile(`http_endpoint_configuration.0.buffering_size must be between 1 and 64 for http_endpoint destination`),
+			},
+		},
+	})
+}
+
 func TestAccAWSKinesisFirehoseDeliveryStream_ElasticsearchConfigEndpointUpdates(t *testing.T) {
 	var stream firehose.DeliveryStreamDescription
 
@@ -1408,7 +2220,7 @@ func TestAccAWSKinesisFirehoseDeliveryStream_ElasticsearchWithVpcConfigUpdates(t
 	}
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckIamServiceLinkedRoleEs(t) },
+		PreCheck:     func() { testAccPreCheck(t) },
 		ErrorCheck:   testAccErrorCheck(t, firehose.EndpointsID),
 		Providers:    testAccProviders,
 		CheckDestroy: testAccCheckKinesisFirehoseDeliveryStreamDestroy,
@@ -1472,6 +2284,40 @@ func TestAccAWSKinesisFirehoseDeliveryStream_missingProcessingConfiguration(t *t
 	})
 }
 
+func testAccCheckKinesisFirehoseDeliveryStreamEncryptionStatus(stream *firehose.DeliveryStreamDescription, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if stream.DeliveryStreamEncryptionConfiguration == nil {
+			return fmt.Errorf("Kinesis Firehose Delivery Stream (%s) has no encryption configuration", aws.StringValue(stream.DeliveryStreamName))
+		}
+
+		if actual := aws.StringValue(stream.DeliveryStreamEncryptionConfiguration.Status); actual != expected {
+			return fmt.Errorf("Kinesis Firehose Delivery Stream (%s) encryption status: expected %s, got %s", aws.StringValue(stream.DeliveryStreamName), expected, actual)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckKinesisFirehoseDeliveryStreamTag verifies the tag directly on the
+// delivery stream, independently of the tags recorded in state.
+func testAccCheckKinesisFirehoseDeliveryStreamTag(name, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).firehoseconn
+
+		tags, err := keyvaluetags.FirehoseListTags(conn, name)
+
+		if err != nil {
+			return err
+		}
+
+		if v := tags.KeyValue(key); v == nil || *v != value {
+			return fmt.Errorf("expected Kinesis Firehose Delivery Stream (%s) tag %s to be %q, got: %v", name, key, value, tags.Map())
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKinesisFirehoseDeliveryStreamExists(n string, v *firehose.DeliveryStreamDescription) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1498,6 +2344,16 @@ func testAccCheckKinesisFirehoseDeliveryStreamExists(n string, v *firehose.Deliv
 	}
 }
 
+func testAccCheckKinesisFirehoseDeliveryStreamRecreated(before, after *firehose.DeliveryStreamDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.TimeValue(before.CreateTimestamp), aws.TimeValue(after.CreateTimestamp); before.Equal(after) {
+			return fmt.Errorf("Kinesis Firehose Delivery Stream (created %s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSKinesisFirehoseDeliveryStreamAttributes(stream *firehose.DeliveryStreamDescription, s3config interface{}, extendedS3config interface{}, redshiftConfig interface{}, elasticsearchConfig interface{}, splunkConfig interface{}, httpEndpointConfig interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if !strings.HasPrefix(*stream.DeliveryStreamName, "terraform-kinesis-firehose") && !strings.HasPrefix(*stream.DeliveryStreamName, "tf-acc-test") {
@@ -2050,6 +2906,28 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 }
 `
 
+func testAccKinesisFirehoseDeliveryStreamConfig_Destination(rInt int, destination string) string {
+	configurationBlock := "s3_configuration"
+	if destination == firehoseDestinationTypeExtendedS3 {
+		configurationBlock = "extended_s3_configuration"
+	}
+
+	return composeConfig(
+		fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt),
+		fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose]
+  name        = "terraform-kinesis-firehose-destination-%[1]d"
+  destination = %[2]q
+
+  %[3]s {
+    role_arn   = aws_iam_role.firehose.arn
+    bucket_arn = aws_s3_bucket.bucket.arn
+  }
+}
+`, rInt, destination, configurationBlock))
+}
+
 func testAccKinesisFirehoseDeliveryStreamConfig_s3basicWithSSE(rName string, rInt int, sseEnabled bool) string {
 	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) +
 		fmt.Sprintf(`
@@ -2097,6 +2975,33 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rName, sseEnabled)
 }
 
+func testAccKinesisFirehoseDeliveryStreamConfig_s3basicWithSSEAndKeyArnAndKeyType(rName string, rInt int, keyType string) string {
+	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) +
+		fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  deletion_window_in_days = 7
+  description             = %[1]q
+}
+
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose]
+  name        = %[1]q
+  destination = "s3"
+
+  s3_configuration {
+    role_arn   = aws_iam_role.firehose.arn
+    bucket_arn = aws_s3_bucket.bucket.arn
+  }
+
+  server_side_encryption {
+    enabled  = true
+    key_arn  = aws_kms_key.test.arn
+    key_type = %[2]q
+  }
+}
+`, rName, keyType)
+}
+
 func testAccKinesisFirehoseDeliveryStreamConfig_s3basicWithSSEAndKeyType(rName string, rInt int, sseEnabled bool, keyType string) string {
 	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) +
 		fmt.Sprintf(`
@@ -2513,6 +3418,41 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rName)
 }
 
+func testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_DataFormatConversionConfiguration_Invalid(rName string, rInt int, databaseName, serializer string) string {
+	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) + fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  destination = "extended_s3"
+  name        = %[1]q
+
+  extended_s3_configuration {
+    bucket_arn  = aws_s3_bucket.bucket.arn
+    buffer_size = 128
+    role_arn    = aws_iam_role.firehose.arn
+
+    data_format_conversion_configuration {
+      input_format_configuration {
+        deserializer {
+          hive_json_ser_de {}
+        }
+      }
+
+      output_format_configuration {
+        serializer {
+          %[3]s
+        }
+      }
+
+      schema_configuration {
+        database_name = %[2]q
+        role_arn      = aws_iam_role.firehose.arn
+        table_name    = %[1]q
+      }
+    }
+  }
+}
+`, rName, databaseName, serializer)
+}
+
 func testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_DataFormatConversionConfiguration_ParquetSerDe_Empty(rName string, rInt int) string {
 	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) + fmt.Sprintf(`
 resource "aws_glue_catalog_database" "test" {
@@ -2612,6 +3552,109 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rName)
 }
 
+func testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_ProcessingConfiguration_Decompression(rName string, rInt int, parameterName string) string {
+	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) + fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  destination = "extended_s3"
+  name        = %[1]q
+
+  extended_s3_configuration {
+    bucket_arn = aws_s3_bucket.bucket.arn
+    role_arn   = aws_iam_role.firehose.arn
+
+    processing_configuration {
+      enabled = true
+
+      processors {
+        type = "Decompression"
+
+        parameters {
+          parameter_name  = %[2]q
+          parameter_value = "GZIP"
+        }
+      }
+
+      processors {
+        type = "CloudWatchLogProcessing"
+
+        parameters {
+          parameter_name  = "DataMessageExtraction"
+          parameter_value = "true"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.firehose]
+}
+`, rName, parameterName)
+}
+
+func testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_ProcessingConfiguration_MessageExtraction(rName string, rInt int) string {
+	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) + fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  destination = "extended_s3"
+  name        = %[1]q
+
+  extended_s3_configuration {
+    bucket_arn = aws_s3_bucket.bucket.arn
+    role_arn   = aws_iam_role.firehose.arn
+
+    processing_configuration {
+      enabled = true
+
+      processors {
+        type = "CloudWatchLogProcessing"
+
+        parameters {
+          parameter_name  = "DataMessageExtraction"
+          parameter_value = "true"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.firehose]
+}
+`, rName)
+}
+
+func testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_BufferingHints(rName string, rInt, bufferInterval, bufferSize int) string {
+	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) + fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  destination = "extended_s3"
+  name        = %[1]q
+
+  extended_s3_configuration {
+    bucket_arn      = aws_s3_bucket.bucket.arn
+    buffer_interval = %[2]d
+    buffer_size     = %[3]d
+    role_arn        = aws_iam_role.firehose.arn
+  }
+
+  depends_on = [aws_iam_role_policy.firehose]
+}
+`, rName, bufferInterval, bufferSize)
+}
+
+func testAccKinesisFirehoseDeliveryStreamConfig_ExtendedS3_Prefixes(rName string, rInt int, prefix, errorOutputPrefix string) string {
+	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt) + fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  destination = "extended_s3"
+  name        = %[1]q
+
+  extended_s3_configuration {
+    bucket_arn          = aws_s3_bucket.bucket.arn
+    error_output_prefix = %[3]q
+    prefix              = %[2]q
+    role_arn            = aws_iam_role.firehose.arn
+  }
+
+  depends_on = [aws_iam_role_policy.firehose]
+}
+`, rName, prefix, errorOutputPrefix)
+}
+
 var testAccKinesisFirehoseDeliveryStreamConfig_extendedS3KmsKeyArn = testAccKinesisFirehoseDeliveryStreamBaseConfig + `
 resource "aws_kms_key" "test" {
   description = "Terraform acc test %s"
@@ -2932,6 +3975,30 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 `, rInt, retryDuration))
 }
 
+func testAccKinesisFirehoseDeliveryStreamConfig_HTTPEndpoint_BufferingSize(rInt, bufferingSize int) string {
+	return composeConfig(
+		fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseConfig, rInt, rInt, rInt),
+		fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on  = [aws_iam_role_policy.firehose]
+  name        = "terraform-kinesis-firehose-httpendpoint-%[1]d"
+  destination = "http_endpoint"
+
+  s3_configuration {
+    role_arn   = aws_iam_role.firehose.arn
+    bucket_arn = aws_s3_bucket.bucket.arn
+  }
+
+  http_endpoint_configuration {
+    url            = "https://input-test.com:443"
+    name           = "HTTP_test"
+    buffering_size = %[2]d
+    role_arn       = aws_iam_role.firehose.arn
+  }
+}
+`, rInt, bufferingSize))
+}
+
 var testAccKinesisFirehoseDeliveryStreamConfig_HTTPEndpointUpdates = testAccKinesisFirehoseDeliveryStreamBaseConfig + `
 resource "aws_kinesis_firehose_delivery_stream" "test" {
   depends_on  = [aws_iam_role_policy.firehose]
@@ -3144,6 +4211,31 @@ resource "aws_kinesis_firehose_delivery_stream" "test" {
 }
 `
 
+func testAccKinesisFirehoseDeliveryStreamConfig_Elasticsearch_BufferingSize(ri, s3BufferSize, bufferingSize int) string {
+	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseElasticsearchConfig, ri, ri, ri, ri, ri) + fmt.Sprintf(`
+resource "aws_kinesis_firehose_delivery_stream" "test" {
+  depends_on = [aws_iam_role_policy.firehose-elasticsearch]
+
+  name        = "terraform-kinesis-firehose-es-%[1]d"
+  destination = "elasticsearch"
+
+  s3_configuration {
+    role_arn    = aws_iam_role.firehose.arn
+    bucket_arn  = aws_s3_bucket.bucket.arn
+    buffer_size = %[2]d
+  }
+
+  elasticsearch_configuration {
+    domain_arn     = aws_elasticsearch_domain.test_cluster.arn
+    role_arn       = aws_iam_role.firehose.arn
+    index_name     = "test"
+    type_name      = "test"
+    buffering_size = %[3]d
+  }
+}
+`, ri, s3BufferSize, bufferingSize)
+}
+
 func testAccKinesisFirehoseDeliveryStreamConfig_ElasticsearchVpcBasic(ri int) string {
 	return fmt.Sprintf(testAccKinesisFirehoseDeliveryStreamBaseElasticsearchVpcConfig+`
 resource "aws_kinesis_firehose_delivery_stream" "test" {
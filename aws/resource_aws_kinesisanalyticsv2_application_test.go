@@ -549,6 +549,42 @@ func TestAccAWSKinesisAnalyticsV2Application_CloudWatchLoggingOptions_Delete(t *
 	})
 }
 
+func TestAccAWSKinesisAnalyticsV2Application_CloudWatchLoggingOptions_Drift(t *testing.T) {
+	var v kinesisanalyticsv2.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	cloudWatchLogStreamResourceName := "aws_cloudwatch_log_stream.test.0"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisAnalyticsV2(t) },
+		ErrorCheck:   testAccErrorCheck(t, kinesisanalyticsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigCloudWatchLoggingOptions(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.log_stream_arn", cloudWatchLogStreamResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+					testAccCheckKinesisAnalyticsV2ApplicationCloudWatchLoggingOptionDelete(&v),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigCloudWatchLoggingOptions(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.log_stream_arn", cloudWatchLogStreamResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSKinesisAnalyticsV2Application_CloudWatchLoggingOptions_Update(t *testing.T) {
 	var v kinesisanalyticsv2.ApplicationDetail
 	resourceName := "aws_kinesisanalyticsv2_application.test"
@@ -930,6 +966,41 @@ func TestAccAWSKinesisAnalyticsV2Application_FlinkApplicationConfiguration_Updat
 	})
 }
 
+func TestAccAWSKinesisAnalyticsV2Application_FlinkApplicationConfiguration_SnapshotsEnabled_Drift(t *testing.T) {
+	var v kinesisanalyticsv2.ApplicationDetail
+	resourceName := "aws_kinesisanalyticsv2_application.test"
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccPreCheckAWSKinesisAnalyticsV2(t) },
+		ErrorCheck:   testAccErrorCheck(t, kinesisanalyticsv2.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKinesisAnalyticsV2ApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigFlinkApplicationConfiguration(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.0.snapshots_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "1"),
+					testAccCheckKinesisAnalyticsV2ApplicationSnapshotsEnabledUpdate(&v, true),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccKinesisAnalyticsV2ApplicationConfigFlinkApplicationConfiguration(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKinesisAnalyticsV2ApplicationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "application_configuration.0.application_snapshot_configuration.0.snapshots_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "3"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSKinesisAnalyticsV2Application_FlinkApplicationConfiguration_EnvironmentProperties_Update(t *testing.T) {
 	var v kinesisanalyticsv2.ApplicationDetail
 	resourceName := "aws_kinesisanalyticsv2_application.test"
@@ -4035,6 +4106,47 @@ func testAccCheckKinesisAnalyticsV2ApplicationExists(n string, v *kinesisanalyti
 	}
 }
 
+// testAccCheckKinesisAnalyticsV2ApplicationSnapshotsEnabledUpdate toggles snapshots outside of Terraform.
+func testAccCheckKinesisAnalyticsV2ApplicationCloudWatchLoggingOptionDelete(v *kinesisanalyticsv2.ApplicationDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+
+		if len(v.CloudWatchLoggingOptionDescriptions) == 0 {
+			return fmt.Errorf("Kinesis Analytics v2 Application (%s) has no CloudWatch logging options", aws.StringValue(v.ApplicationName))
+		}
+
+		input := &kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionInput{
+			ApplicationName:             v.ApplicationName,
+			CloudWatchLoggingOptionId:   v.CloudWatchLoggingOptionDescriptions[0].CloudWatchLoggingOptionId,
+			CurrentApplicationVersionId: v.ApplicationVersionId,
+		}
+
+		_, err := conn.DeleteApplicationCloudWatchLoggingOption(input)
+
+		return err
+	}
+}
+
+func testAccCheckKinesisAnalyticsV2ApplicationSnapshotsEnabledUpdate(v *kinesisanalyticsv2.ApplicationDetail, enabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
+
+		input := &kinesisanalyticsv2.UpdateApplicationInput{
+			ApplicationName: v.ApplicationName,
+			ApplicationConfigurationUpdate: &kinesisanalyticsv2.ApplicationConfigurationUpdate{
+				ApplicationSnapshotConfigurationUpdate: &kinesisanalyticsv2.ApplicationSnapshotConfigurationUpdate{
+					SnapshotsEnabledUpdate: aws.Bool(enabled),
+				},
+			},
+			CurrentApplicationVersionId: v.ApplicationVersionId,
+		}
+
+		_, err := conn.UpdateApplication(input)
+
+		return err
+	}
+}
+
 func testAccPreCheckAWSKinesisAnalyticsV2(t *testing.T) {
 	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsv2conn
 
@@ -66,18 +66,24 @@ func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}
 		EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
 	}
 
-	if len(tags) > 0 {
-		createOpts.Tags = tags.IgnoreAws().SagemakerTags()
+	if createTags := meta.(*AWSClient).TagsOnCreate(tags); len(createTags) > 0 {
+		createOpts.Tags = createTags.IgnoreAws().SagemakerTags()
 	}
 
 	log.Printf("[DEBUG] SageMaker Endpoint create config: %#v", *createOpts)
-	_, err := conn.CreateEndpoint(createOpts)
+	output, err := conn.CreateEndpoint(createOpts)
 	if err != nil {
 		return fmt.Errorf("error creating SageMaker Endpoint: %s", err)
 	}
 
 	d.SetId(name)
 
+	if meta.(*AWSClient).skipTagOnCreate && len(tags) > 0 {
+		if err := keyvaluetags.SagemakerUpdateTags(conn, aws.StringValue(output.EndpointArn), nil, tags.IgnoreAws()); err != nil {
+			return fmt.Errorf("error adding SageMaker Endpoint (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	describeInput := &sagemaker.DescribeEndpointInput{
 		EndpointName: aws.String(name),
 	}
@@ -1,17 +1,25 @@
 package aws
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/keyvaluetags"
+	tfkms "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/kms"
+	tfsagemaker "github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
 )
@@ -48,6 +56,7 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 									"max_concurrent_invocations_per_instance": {
 										Type:         schema.TypeInt,
 										Optional:     true,
+										Computed:     true,
 										ForceNew:     true,
 										ValidateFunc: validation.IntBetween(1, 1000),
 									},
@@ -105,6 +114,20 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 				},
 			},
 
+			"creation_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"in_use_by_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"lookup_in_use_by_endpoints": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -158,6 +181,7 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 							Optional:     true,
 							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice(sagemaker.ProductionVariantAcceleratorType_Values(), false),
+							Deprecated:   "Amazon Elastic Inference is being retired. Use an instance_type from the ml.inf1 or ml.inf2 families instead.",
 						},
 					},
 				},
@@ -170,6 +194,12 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 				ValidateFunc: validateArn,
 			},
 
+			"resolve_kms_key_alias": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags":     tagsSchema(),
 			"tags_all": tagsSchemaComputed(),
 
@@ -270,8 +300,90 @@ func resourceAwsSagemakerEndpointConfiguration() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			SetTagsDiff,
+			resourceAwsSagemakerEndpointConfigurationCustomizeDiff,
+		),
+	}
+}
+
+func resourceAwsSagemakerEndpointConfigurationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !meta.(*AWSClient).validateSagemakerModelNames {
+		return nil
+	}
+
+	if !diff.HasChange("production_variants") && !diff.HasChange("data_capture_config") {
+		return nil
+	}
+
+	conn := meta.(*AWSClient).sagemakerconn
+	enableCapture := diff.NewValueKnown("data_capture_config.0.enable_capture") && diff.Get("data_capture_config.0.enable_capture").(bool)
+
+	for i := range diff.Get("production_variants").([]interface{}) {
+		key := fmt.Sprintf("production_variants.%d.model_name", i)
+
+		// Models created in the same configuration are not known until apply.
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		modelName := diff.Get(key).(string)
+
+		if modelName == "" {
+			continue
+		}
+
+		model, err := finder.ModelByName(conn, modelName)
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("%s: SageMaker Model (%s) not found", key, modelName)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading SageMaker Model (%s): %w", modelName, err)
+		}
+
+		if msg := sagemakerDataCaptureNetworkIsolationWarning(key, enableCapture, model); msg != "" {
+			log.Printf("[WARN] SageMaker Endpoint Configuration (%s): %s", diff.Id(), msg)
+		}
 	}
+
+	return nil
+}
+
+// sagemakerDataCaptureNetworkIsolationWarning returns a message when data capture is enabled
+// for a model with network isolation, as captured data may not egress to S3 from the container.
+// The combination is accepted by the API, so it does not fail the plan.
+func sagemakerDataCaptureNetworkIsolationWarning(key string, enableCapture bool, model *sagemaker.DescribeModelOutput) string {
+	if !enableCapture || model == nil || !aws.BoolValue(model.EnableNetworkIsolation) {
+		return ""
+	}
+
+	return fmt.Sprintf("data_capture_config.0.enable_capture is true but %s SageMaker Model (%s) has network isolation enabled, captured data may not be delivered to S3", key, aws.StringValue(model.ModelName))
+}
+
+// validateSagemakerS3OutputBucket verifies that the bucket of an s3://bucket/prefix output path exists.
+// Other output path forms, such as https:// URLs, are not checked.
+func validateSagemakerS3OutputBucket(conn *s3.S3, s3OutputPath string) error {
+	if !strings.HasPrefix(s3OutputPath, "s3://") {
+		return nil
+	}
+
+	bucket := strings.SplitN(strings.TrimPrefix(s3OutputPath, "s3://"), "/", 2)[0]
+
+	_, err := conn.HeadBucket(&s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) || tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		return fmt.Errorf("S3 Bucket (%s) does not exist", bucket)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return nil
 }
 
 func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -295,8 +407,8 @@ func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, met
 		createOpts.KmsKeyId = aws.String(v.(string))
 	}
 
-	if len(tags) > 0 {
-		createOpts.Tags = tags.IgnoreAws().SagemakerTags()
+	if createTags := meta.(*AWSClient).TagsOnCreate(tags); len(createTags) > 0 {
+		createOpts.Tags = createTags.IgnoreAws().SagemakerTags()
 	}
 
 	if v, ok := d.GetOk("data_capture_config"); ok {
@@ -305,15 +417,27 @@ func resourceAwsSagemakerEndpointConfigurationCreate(d *schema.ResourceData, met
 
 	if v, ok := d.GetOk("async_inference_config"); ok {
 		createOpts.AsyncInferenceConfig = expandSagemakerEndpointConfigAsyncInferenceConfig(v.([]interface{}))
+
+		if meta.(*AWSClient).validateSagemakerS3OutputBuckets && createOpts.AsyncInferenceConfig.OutputConfig != nil {
+			if err := validateSagemakerS3OutputBucket(meta.(*AWSClient).s3conn, aws.StringValue(createOpts.AsyncInferenceConfig.OutputConfig.S3OutputPath)); err != nil {
+				return fmt.Errorf("error creating SageMaker Endpoint Configuration: async_inference_config.0.output_config.0.s3_output_path: %w", err)
+			}
+		}
 	}
 
 	log.Printf("[DEBUG] SageMaker Endpoint Configuration create config: %#v", *createOpts)
-	_, err := conn.CreateEndpointConfig(createOpts)
+	output, err := conn.CreateEndpointConfig(createOpts)
 	if err != nil {
 		return fmt.Errorf("error creating SageMaker Endpoint Configuration: %w", err)
 	}
 	d.SetId(name)
 
+	if meta.(*AWSClient).skipTagOnCreate && len(tags) > 0 {
+		if err := keyvaluetags.SagemakerUpdateTags(conn, aws.StringValue(output.EndpointConfigArn), nil, tags.IgnoreAws()); err != nil {
+			return fmt.Errorf("error adding SageMaker Endpoint Configuration (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	return resourceAwsSagemakerEndpointConfigurationRead(d, meta)
 }
 
@@ -335,8 +459,18 @@ func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta
 	}
 
 	d.Set("arn", endpointConfig.EndpointConfigArn)
+	d.Set("creation_time", aws.TimeValue(endpointConfig.CreationTime).Format(time.RFC3339))
 	d.Set("name", endpointConfig.EndpointConfigName)
-	d.Set("kms_key_arn", endpointConfig.KmsKeyId)
+
+	// Keep a configured KMS alias in state if it resolves to the stored key ARN.
+	kmsKeyARN, err := tfkms.NormalizeKeyARN(meta.(*AWSClient).kmsconn, d.Get("kms_key_arn").(string), aws.StringValue(endpointConfig.KmsKeyId), d.Get("resolve_kms_key_alias").(bool))
+
+	if err != nil {
+		log.Printf("[WARN] SageMaker Endpoint Configuration (%s): %s", d.Id(), err)
+		kmsKeyARN = aws.StringValue(endpointConfig.KmsKeyId)
+	}
+
+	d.Set("kms_key_arn", kmsKeyARN)
 
 	if err := d.Set("production_variants", flattenProductionVariants(endpointConfig.ProductionVariants)); err != nil {
 		return fmt.Errorf("error setting production_variants for SageMaker Endpoint Configuration (%s): %w", d.Id(), err)
@@ -350,6 +484,24 @@ func resourceAwsSagemakerEndpointConfigurationRead(d *schema.ResourceData, meta
 		return fmt.Errorf("error setting async_inference_config for SageMaker Endpoint Configuration (%s): %w", d.Id(), err)
 	}
 
+	// Finding the endpoints describes every endpoint in the region, so it is opt-in.
+	if d.Get("lookup_in_use_by_endpoints").(bool) {
+		endpointNames, err := finder.EndpointsUsingConfig(conn, d.Id())
+
+		switch {
+		case tfawserr.ErrCodeEquals(err, tfsagemaker.ErrCodeAccessDeniedException), tfawserr.ErrCodeEquals(err, tfsagemaker.ErrCodeThrottlingException):
+			log.Printf("[WARN] Unable to list SageMaker Endpoints using Endpoint Configuration (%s), in_use_by_endpoints will not be updated: %s", d.Id(), err)
+		case err != nil:
+			return fmt.Errorf("error listing SageMaker Endpoints using Endpoint Configuration (%s): %w", d.Id(), err)
+		default:
+			if err := d.Set("in_use_by_endpoints", endpointNames); err != nil {
+				return fmt.Errorf("error setting in_use_by_endpoints for SageMaker Endpoint Configuration (%s): %w", d.Id(), err)
+			}
+		}
+	} else {
+		d.Set("in_use_by_endpoints", nil)
+	}
+
 	tags, err := keyvaluetags.SagemakerListTags(conn, aws.StringValue(endpointConfig.EndpointConfigArn))
 	if err != nil {
 		return fmt.Errorf("error listing tags for Sagemaker Endpoint Configuration (%s): %w", d.Id(), err)
@@ -553,13 +705,19 @@ func flattenSagemakerCaptureContentTypeHeader(contentTypeHeader *sagemaker.Captu
 		return []map[string]interface{}{}
 	}
 
+	// The header is only sent on create when at least one content type set is
+	// configured, so omit the block entirely rather than reading back empty sets.
+	if len(contentTypeHeader.CsvContentTypes) == 0 && len(contentTypeHeader.JsonContentTypes) == 0 {
+		return []map[string]interface{}{}
+	}
+
 	l := make(map[string]interface{})
 
-	if contentTypeHeader.CsvContentTypes != nil {
+	if len(contentTypeHeader.CsvContentTypes) > 0 {
 		l["csv_content_types"] = flattenStringSet(contentTypeHeader.CsvContentTypes)
 	}
 
-	if contentTypeHeader.JsonContentTypes != nil {
+	if len(contentTypeHeader.JsonContentTypes) > 0 {
 		l["json_content_types"] = flattenStringSet(contentTypeHeader.JsonContentTypes)
 	}
 
@@ -595,8 +753,8 @@ func expandSagemakerEndpointConfigClientConfig(configured []interface{}) *sagema
 
 	c := &sagemaker.AsyncInferenceClientConfig{}
 
-	if v, ok := m["max_concurrent_invocations_per_instance"]; ok {
-		c.MaxConcurrentInvocationsPerInstance = aws.Int64(int64(v.(int)))
+	if v, ok := m["max_concurrent_invocations_per_instance"].(int); ok && v > 0 {
+		c.MaxConcurrentInvocationsPerInstance = aws.Int64(int64(v))
 	}
 
 	return c
@@ -667,6 +825,8 @@ func flattenSagemakerEndpointConfigClientConfig(config *sagemaker.AsyncInference
 		return []map[string]interface{}{}
 	}
 
+	// Only known fields are read back; any fields AWS adds to the client
+	// configuration are ignored rather than causing an error or a diff.
 	cfg := map[string]interface{}{}
 
 	if config.MaxConcurrentInvocationsPerInstance != nil {
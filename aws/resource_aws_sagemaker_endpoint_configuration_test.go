@@ -3,13 +3,22 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/service/sagemaker/finder"
 	"github.com/terraform-providers/terraform-provider-aws/aws/internal/tfresource"
@@ -65,6 +74,33 @@ func testSweepSagemakerEndpointConfigurations(region string) error {
 	return sweeperErrs.ErrorOrNil()
 }
 
+func TestResourceAwsSagemakerEndpointConfigurationAcceleratorTypeDeprecated(t *testing.T) {
+	raw := map[string]interface{}{
+		"production_variants": []interface{}{
+			map[string]interface{}{
+				"accelerator_type":       sagemaker.ProductionVariantAcceleratorTypeMlEia1Medium,
+				"initial_instance_count": 1,
+				"instance_type":          sagemaker.ProductionVariantInstanceTypeMlT2Medium,
+				"model_name":             "test",
+			},
+		},
+	}
+
+	diags := resourceAwsSagemakerEndpointConfiguration().Validate(terraform.NewResourceConfigRaw(raw))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %#v", diags)
+	}
+
+	for _, d := range diags {
+		if d.Severity == diag.Warning && strings.Contains(d.Summary+d.Detail, "Elastic Inference") {
+			return
+		}
+	}
+
+	t.Errorf("expected accelerator_type deprecation warning, got: %#v", diags)
+}
+
 func TestAccAWSSagemakerEndpointConfiguration_basic(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_endpoint_configuration.test"
@@ -89,6 +125,8 @@ func TestAccAWSSagemakerEndpointConfiguration_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "production_variants.0.code_dump_config.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "data_capture_config.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "async_inference_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "in_use_by_endpoints.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
 				),
 			},
 			{
@@ -153,6 +191,23 @@ func TestAccAWSSagemakerEndpointConfiguration_productionVariants_AcceleratorType
 	})
 }
 
+func TestAccAWSSagemakerEndpointConfiguration_productionVariants_ValidateModelName(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ErrorCheck:        testAccErrorCheck(t, sagemaker.EndpointsID),
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSagemakerEndpointConfigurationConfig_ProductionVariants_ValidateModelName(rName),
+				ExpectError: regexp.MustCompile(`SageMaker Model \(` + rName + `\) not found`),
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerEndpointConfiguration_kmsKeyId(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_endpoint_configuration.test"
@@ -179,6 +234,56 @@ func TestAccAWSSagemakerEndpointConfiguration_kmsKeyId(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerEndpointConfiguration_lookupInUseByEndpoints(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_endpoint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfigurationConfig_LookupInUseByEndpoints(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lookup_in_use_by_endpoints", "true"),
+					resource.TestCheckResourceAttr(resourceName, "in_use_by_endpoints.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"in_use_by_endpoints", "lookup_in_use_by_endpoints"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpointConfiguration_kmsKeyAlias(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_endpoint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfiguration_Config_KmsKeyAlias(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointConfigurationExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_arn", "aws_kms_alias.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resolve_kms_key_alias", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerEndpointConfiguration_tags(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_endpoint_configuration.test"
@@ -256,6 +361,39 @@ func TestAccAWSSagemakerEndpointConfiguration_dataCaptureConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerEndpointConfiguration_dataCaptureConfig_csvContentTypes(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_endpoint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfigurationDataCaptureConfigCsvContentTypes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "data_capture_config.0.capture_content_type_header.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data_capture_config.0.capture_content_type_header.0.csv_content_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "data_capture_config.0.capture_content_type_header.0.csv_content_types.*", "text/csv"),
+					resource.TestCheckResourceAttr(resourceName, "data_capture_config.0.capture_content_type_header.0.json_content_types.#", "0"),
+				),
+			},
+			{
+				Config:   testAccSagemakerEndpointConfigurationDataCaptureConfigCsvContentTypes(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerEndpointConfiguration_disappears(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_endpoint_configuration.test"
@@ -375,6 +513,321 @@ func TestAccAWSSagemakerEndpointConfiguration_async_client(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerEndpointConfiguration_async_client_unsetMaxConcurrentInvocations(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_endpoint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfigurationConfigAsyncClientConfigEmpty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointConfigurationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "async_inference_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "async_inference_config.0.client_config.#", "1"),
+				),
+			},
+			{
+				Config:   testAccSagemakerEndpointConfigurationConfigAsyncClientConfigEmpty(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSagemakerDataCaptureNetworkIsolationWarning(t *testing.T) {
+	testCases := []struct {
+		name          string
+		enableCapture bool
+		model         *sagemaker.DescribeModelOutput
+		expectedMsg   bool
+	}{
+		{
+			name:          "capture disabled",
+			enableCapture: false,
+			model: &sagemaker.DescribeModelOutput{
+				ModelName:              aws.String("test"),
+				EnableNetworkIsolation: aws.Bool(true),
+			},
+		},
+		{
+			name:          "network isolation disabled",
+			enableCapture: true,
+			model: &sagemaker.DescribeModelOutput{
+				ModelName:              aws.String("test"),
+				EnableNetworkIsolation: aws.Bool(false),
+			},
+		},
+		{
+			name:          "network isolation unset",
+			enableCapture: true,
+			model: &sagemaker.DescribeModelOutput{
+				ModelName: aws.String("test"),
+			},
+		},
+		{
+			name:          "capture enabled with network isolation",
+			enableCapture: true,
+			model: &sagemaker.DescribeModelOutput{
+				ModelName:              aws.String("test"),
+				EnableNetworkIsolation: aws.Bool(true),
+			},
+			expectedMsg: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg := sagemakerDataCaptureNetworkIsolationWarning("production_variants.0.model_name", testCase.enableCapture, testCase.model)
+
+			if testCase.expectedMsg && msg == "" {
+				t.Fatal("expected warning, got none")
+			}
+
+			if !testCase.expectedMsg && msg != "" {
+				t.Fatalf("unexpected warning: %s", msg)
+			}
+		})
+	}
+}
+
+func TestValidateSagemakerS3OutputBucket(t *testing.T) {
+	testCases := []struct {
+		name       string
+		path       string
+		err        error
+		wantBucket string
+		wantErr    *regexp.Regexp
+	}{
+		{
+			name:       "exists",
+			path:       "s3://test-bucket/output/",
+			wantBucket: "test-bucket",
+		},
+		{
+			name:       "exists without prefix",
+			path:       "s3://test-bucket",
+			wantBucket: "test-bucket",
+		},
+		{
+			name:       "not found",
+			path:       "s3://missing-bucket/output",
+			err:        awserr.NewRequestFailure(awserr.New("NotFound", "Not Found", nil), 404, "request-id"),
+			wantBucket: "missing-bucket",
+			wantErr:    regexp.MustCompile(`S3 Bucket \(missing-bucket\) does not exist`),
+		},
+		{
+			name: "https URL",
+			path: "https://test-bucket.s3.amazonaws.com/output/",
+		},
+		{
+			name:       "access denied",
+			path:       "s3://other-bucket/output",
+			err:        awserr.NewRequestFailure(awserr.New("Forbidden", "Forbidden", nil), 403, "request-id"),
+			wantBucket: "other-bucket",
+			wantErr:    regexp.MustCompile(`error reading S3 Bucket \(other-bucket\)`),
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := s3.New(sess)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var bucket string
+
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if params, ok := r.Params.(*s3.HeadBucketInput); ok {
+					bucket = aws.StringValue(params.Bucket)
+					r.Error = testCase.err
+				}
+			})
+
+			err := validateSagemakerS3OutputBucket(conn, testCase.path)
+
+			if bucket != testCase.wantBucket {
+				t.Errorf("expected HeadBucket for %q, got %q", testCase.wantBucket, bucket)
+			}
+
+			if testCase.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.wantErr != nil && (err == nil || !testCase.wantErr.MatchString(err.Error())) {
+				t.Fatalf("expected error matching %q, got: %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestExpandSagemakerEndpointConfigClientConfig(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *sagemaker.AsyncInferenceClientConfig
+	}{
+		{
+			Name:     "empty",
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		{
+			Name:     "unset",
+			Input:    []interface{}{map[string]interface{}{"max_concurrent_invocations_per_instance": 0}},
+			Expected: &sagemaker.AsyncInferenceClientConfig{},
+		},
+		{
+			Name:     "set",
+			Input:    []interface{}{map[string]interface{}{"max_concurrent_invocations_per_instance": 4}},
+			Expected: &sagemaker.AsyncInferenceClientConfig{MaxConcurrentInvocationsPerInstance: aws.Int64(4)},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := expandSagemakerEndpointConfigClientConfig(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenSagemakerEndpointConfigClientConfig(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *sagemaker.AsyncInferenceClientConfig
+		Expected []map[string]interface{}
+	}{
+		{
+			Name:     "nil",
+			Input:    nil,
+			Expected: []map[string]interface{}{},
+		},
+		{
+			Name:     "unset",
+			Input:    &sagemaker.AsyncInferenceClientConfig{},
+			Expected: []map[string]interface{}{{}},
+		},
+		{
+			Name:     "set",
+			Input:    &sagemaker.AsyncInferenceClientConfig{MaxConcurrentInvocationsPerInstance: aws.Int64(4)},
+			Expected: []map[string]interface{}{{"max_concurrent_invocations_per_instance": int64(4)}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenSagemakerEndpointConfigClientConfig(testCase.Input)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestFlattenSagemakerCaptureContentTypeHeader(t *testing.T) {
+	testCases := []struct {
+		Name         string
+		Input        *sagemaker.CaptureContentTypeHeader
+		ExpectedCsv  []string
+		ExpectedJson []string
+		ExpectEmpty  bool
+	}{
+		{
+			Name:        "nil",
+			Input:       nil,
+			ExpectEmpty: true,
+		},
+		{
+			Name:        "unset",
+			Input:       &sagemaker.CaptureContentTypeHeader{},
+			ExpectEmpty: true,
+		},
+		{
+			Name: "empty sets",
+			Input: &sagemaker.CaptureContentTypeHeader{
+				CsvContentTypes:  []*string{},
+				JsonContentTypes: []*string{},
+			},
+			ExpectEmpty: true,
+		},
+		{
+			Name: "csv only",
+			Input: &sagemaker.CaptureContentTypeHeader{
+				CsvContentTypes:  aws.StringSlice([]string{"text/csv"}),
+				JsonContentTypes: []*string{},
+			},
+			ExpectedCsv: []string{"text/csv"},
+		},
+		{
+			Name: "both",
+			Input: &sagemaker.CaptureContentTypeHeader{
+				CsvContentTypes:  aws.StringSlice([]string{"text/csv"}),
+				JsonContentTypes: aws.StringSlice([]string{"application/json"}),
+			},
+			ExpectedCsv:  []string{"text/csv"},
+			ExpectedJson: []string{"application/json"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := flattenSagemakerCaptureContentTypeHeader(testCase.Input)
+
+			if testCase.ExpectEmpty {
+				if len(got) != 0 {
+					t.Fatalf("expected no block, got %v", got)
+				}
+				return
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected 1 block, got %d", len(got))
+			}
+
+			for key, expected := range map[string][]string{
+				"csv_content_types":  testCase.ExpectedCsv,
+				"json_content_types": testCase.ExpectedJson,
+			} {
+				v, ok := got[0][key]
+
+				if len(expected) == 0 {
+					if ok {
+						t.Errorf("expected %s to be omitted, got %v", key, v)
+					}
+					continue
+				}
+
+				if !ok {
+					t.Fatalf("expected %s to be set", key)
+				}
+
+				if actual := expandStringSet(v.(*schema.Set)); !reflect.DeepEqual(aws.StringValueSlice(actual), expected) {
+					t.Errorf("%s: got %v, expected %v", key, aws.StringValueSlice(actual), expected)
+				}
+			}
+		})
+	}
+}
+
 func testAccCheckSagemakerEndpointConfigurationDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
 
@@ -471,6 +924,23 @@ resource "aws_sagemaker_endpoint_configuration" "test" {
 `, rName)
 }
 
+func testAccSagemakerEndpointConfigurationConfig_LookupInUseByEndpoints(rName string) string {
+	return testAccSagemakerEndpointConfigurationConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name                       = %q
+  lookup_in_use_by_endpoints = true
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = aws_sagemaker_model.test.name
+    initial_instance_count = 2
+    instance_type          = "ml.t2.medium"
+    initial_variant_weight = 1
+  }
+}
+`, rName)
+}
+
 func testAccSagemakerEndpointConfigurationConfig_ProductionVariants_InitialVariantWeight(rName string) string {
 	return testAccSagemakerEndpointConfigurationConfig_Base(rName) + fmt.Sprintf(`
 resource "aws_sagemaker_endpoint_configuration" "test" {
@@ -511,6 +981,26 @@ resource "aws_sagemaker_endpoint_configuration" "test" {
 `, rName)
 }
 
+func testAccSagemakerEndpointConfigurationConfig_ProductionVariants_ValidateModelName(rName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  validate_sagemaker_model_names = true
+}
+
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name = %[1]q
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = %[1]q
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+  }
+}
+`, rName)
+}
+
 func testAccSagemakerEndpointConfiguration_Config_KmsKeyId(rName string) string {
 	return testAccSagemakerEndpointConfigurationConfig_Base(rName) + fmt.Sprintf(`
 resource "aws_sagemaker_endpoint_configuration" "test" {
@@ -533,6 +1023,34 @@ resource "aws_kms_key" "test" {
 `, rName)
 }
 
+func testAccSagemakerEndpointConfiguration_Config_KmsKeyAlias(rName string) string {
+	return testAccSagemakerEndpointConfigurationConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name                  = %[1]q
+  kms_key_arn           = aws_kms_alias.test.arn
+  resolve_kms_key_alias = true
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = aws_sagemaker_model.test.name
+    initial_instance_count = 1
+    instance_type          = "ml.t2.medium"
+    initial_variant_weight = 1
+  }
+}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 10
+}
+
+resource "aws_kms_alias" "test" {
+  name          = "alias/%[1]s"
+  target_key_id = aws_kms_key.test.key_id
+}
+`, rName)
+}
+
 func testAccSagemakerEndpointConfigurationConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return testAccSagemakerEndpointConfigurationConfig_Base(rName) + fmt.Sprintf(`
 resource "aws_sagemaker_endpoint_configuration" "test" {
@@ -614,6 +1132,46 @@ resource "aws_sagemaker_endpoint_configuration" "test" {
 `, rName)
 }
 
+func testAccSagemakerEndpointConfigurationDataCaptureConfigCsvContentTypes(rName string) string {
+	return testAccSagemakerEndpointConfigurationConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name = %[1]q
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = aws_sagemaker_model.test.name
+    initial_instance_count = 2
+    instance_type          = "ml.t2.medium"
+    initial_variant_weight = 1
+  }
+
+  data_capture_config {
+    enable_capture              = true
+    initial_sampling_percentage = 50
+    destination_s3_uri          = "s3://${aws_s3_bucket.test.bucket}/"
+
+    capture_options {
+      capture_mode = "Input"
+    }
+
+    capture_options {
+      capture_mode = "Output"
+    }
+
+    capture_content_type_header {
+      csv_content_types = ["text/csv"]
+    }
+  }
+}
+`, rName)
+}
+
 func testAccSagemakerEndpointConfigurationConfigAsyncConfig(rName string) string {
 	return testAccSagemakerEndpointConfigurationConfig_Base(rName) + fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -728,3 +1286,33 @@ resource "aws_sagemaker_endpoint_configuration" "test" {
 }
 `, rName)
 }
+
+func testAccSagemakerEndpointConfigurationConfigAsyncClientConfigEmpty(rName string) string {
+	return testAccSagemakerEndpointConfigurationConfig_Base(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "aws_sagemaker_endpoint_configuration" "test" {
+  name = %[1]q
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = aws_sagemaker_model.test.name
+    initial_instance_count = 2
+    instance_type          = "ml.t2.medium"
+    initial_variant_weight = 1
+  }
+
+  async_inference_config {
+    client_config {}
+
+    output_config {
+      s3_output_path = "s3://${aws_s3_bucket.test.bucket}/"
+    }
+  }
+}
+`, rName)
+}
@@ -84,6 +84,36 @@ func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerEndpoint_EndpointConfigInUse(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_endpoint.test"
+	endpointConfigResourceName := "aws_sagemaker_endpoint_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerEndpointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerEndpointExists(resourceName),
+				),
+			},
+			{
+				// The endpoint configuration is read before the endpoint is created,
+				// so the reference is only visible after a refresh.
+				Config: testAccSagemakerEndpointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(endpointConfigResourceName, "in_use_by_endpoints.#", "1"),
+					resource.TestCheckResourceAttrPair(endpointConfigResourceName, "in_use_by_endpoints.0", resourceName, "name"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerEndpoint_EndpointConfigName(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 
@@ -232,7 +232,7 @@ func resourceAwsSagemakerFeatureGroupCreate(d *schema.ResourceData, meta interfa
 	err := resource.Retry(iamwaiter.PropagationTimeout, func() *resource.RetryError {
 		_, err := conn.CreateFeatureGroup(input)
 		if err != nil {
-			if isAWSErr(err, "ValidationException", "The execution role ARN is invalid.") {
+			if iamwaiter.IsPropagationError(err) {
 				return resource.RetryableError(err)
 			}
 			if isAWSErr(err, "ValidationException", "Invalid S3Uri provided") {
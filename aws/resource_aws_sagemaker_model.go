@@ -231,8 +231,8 @@ func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) e
 		createOpts.ExecutionRoleArn = aws.String(v.(string))
 	}
 
-	if len(tags) > 0 {
-		createOpts.Tags = tags.IgnoreAws().SagemakerTags()
+	if createTags := meta.(*AWSClient).TagsOnCreate(tags); len(createTags) > 0 {
+		createOpts.Tags = createTags.IgnoreAws().SagemakerTags()
 	}
 
 	if v, ok := d.GetOk("vpc_config"); ok {
@@ -248,7 +248,7 @@ func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	log.Printf("[DEBUG] Sagemaker model create config: %#v", *createOpts)
-	_, err := retryOnAwsCode("ValidationException", func() (interface{}, error) {
+	output, err := retryOnAwsCode("ValidationException", func() (interface{}, error) {
 		return conn.CreateModel(createOpts)
 	})
 
@@ -257,6 +257,12 @@ func resourceAwsSagemakerModelCreate(d *schema.ResourceData, meta interface{}) e
 	}
 	d.SetId(name)
 
+	if meta.(*AWSClient).skipTagOnCreate && len(tags) > 0 {
+		if err := keyvaluetags.SagemakerUpdateTags(conn, aws.StringValue(output.(*sagemaker.CreateModelOutput).ModelArn), nil, tags.IgnoreAws()); err != nil {
+			return fmt.Errorf("error adding Sagemaker Model (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	return resourceAwsSagemakerModelRead(d, meta)
 }
 
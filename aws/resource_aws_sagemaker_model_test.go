@@ -163,6 +163,39 @@ func TestAccAWSSagemakerModel_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSSagemakerModel_skipTagOnCreate(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSagemakerModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: composeConfig(
+					testAccProviderConfigSkipTagOnCreate(),
+					testAccSagemakerModelConfigTags1(rName, "key1", "value1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSagemakerModelExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+			{
+				Config: composeConfig(
+					testAccProviderConfigSkipTagOnCreate(),
+					testAccSagemakerModelConfigTags1(rName, "key1", "value1"),
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSSagemakerModel_primaryContainerModelDataUrl(t *testing.T) {
 	rName := acctest.RandomWithPrefix("tf-acc-test")
 	resourceName := "aws_sagemaker_model.test"
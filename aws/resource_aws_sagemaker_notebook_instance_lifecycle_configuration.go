@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"encoding/base64"
 	"fmt"
 	"log"
 
@@ -35,15 +36,33 @@ func resourceAwsSagemakerNotebookInstanceLifeCycleConfiguration() *schema.Resour
 			},
 
 			"on_create": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 16384),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(0, 16384),
+				ConflictsWith: []string{"on_create_content"},
+			},
+
+			// The plaintext variants are base64 encoded before being sent, so they
+			// are limited to the length that encodes to 16384 characters.
+			"on_create_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(0, 12288),
+				ConflictsWith: []string{"on_create"},
 			},
 
 			"on_start": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 16384),
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(0, 16384),
+				ConflictsWith: []string{"on_start_content"},
+			},
+
+			"on_start_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringLenBetween(0, 12288),
+				ConflictsWith: []string{"on_start"},
 			},
 		},
 	}
@@ -65,15 +84,8 @@ func resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationCreate(d *schema.
 
 	// on_create is technically a list of NotebookInstanceLifecycleHook elements, but the list has to be length 1
 	// (same for on_start)
-	if v, ok := d.GetOk("on_create"); ok {
-		hook := &sagemaker.NotebookInstanceLifecycleHook{Content: aws.String(v.(string))}
-		createOpts.OnCreate = []*sagemaker.NotebookInstanceLifecycleHook{hook}
-	}
-
-	if v, ok := d.GetOk("on_start"); ok {
-		hook := &sagemaker.NotebookInstanceLifecycleHook{Content: aws.String(v.(string))}
-		createOpts.OnStart = []*sagemaker.NotebookInstanceLifecycleHook{hook}
-	}
+	createOpts.OnCreate = expandSagemakerNotebookInstanceLifecycleHooks(d.Get("on_create").(string), d.Get("on_create_content").(string))
+	createOpts.OnStart = expandSagemakerNotebookInstanceLifecycleHooks(d.Get("on_start").(string), d.Get("on_start_content").(string))
 
 	log.Printf("[DEBUG] SageMaker notebook instance lifecycle configuration create config: %#v", *createOpts)
 	_, err := conn.CreateNotebookInstanceLifecycleConfig(createOpts)
@@ -106,16 +118,12 @@ func resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationRead(d *schema.Re
 		return fmt.Errorf("error setting name for SageMaker notebook instance lifecycle configuration (%s): %s", d.Id(), err)
 	}
 
-	if len(lifecycleConfig.OnCreate) > 0 && lifecycleConfig.OnCreate[0] != nil {
-		if err := d.Set("on_create", lifecycleConfig.OnCreate[0].Content); err != nil {
-			return fmt.Errorf("error setting on_create for SageMaker notebook instance lifecycle configuration (%s): %s", d.Id(), err)
-		}
+	if err := setSagemakerNotebookInstanceLifecycleHooks(d, "on_create", lifecycleConfig.OnCreate); err != nil {
+		return fmt.Errorf("error setting on_create for SageMaker notebook instance lifecycle configuration (%s): %s", d.Id(), err)
 	}
 
-	if len(lifecycleConfig.OnStart) > 0 && lifecycleConfig.OnStart[0] != nil {
-		if err := d.Set("on_start", lifecycleConfig.OnStart[0].Content); err != nil {
-			return fmt.Errorf("error setting on_start for SageMaker notebook instance lifecycle configuration (%s): %s", d.Id(), err)
-		}
+	if err := setSagemakerNotebookInstanceLifecycleHooks(d, "on_start", lifecycleConfig.OnStart); err != nil {
+		return fmt.Errorf("error setting on_start for SageMaker notebook instance lifecycle configuration (%s): %s", d.Id(), err)
 	}
 
 	if err := d.Set("arn", lifecycleConfig.NotebookInstanceLifecycleConfigArn); err != nil {
@@ -132,15 +140,8 @@ func resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationUpdate(d *schema.
 		NotebookInstanceLifecycleConfigName: aws.String(d.Get("name").(string)),
 	}
 
-	if v, ok := d.GetOk("on_create"); ok {
-		onCreateHook := &sagemaker.NotebookInstanceLifecycleHook{Content: aws.String(v.(string))}
-		updateOpts.OnCreate = []*sagemaker.NotebookInstanceLifecycleHook{onCreateHook}
-	}
-
-	if v, ok := d.GetOk("on_start"); ok {
-		onStartHook := &sagemaker.NotebookInstanceLifecycleHook{Content: aws.String(v.(string))}
-		updateOpts.OnStart = []*sagemaker.NotebookInstanceLifecycleHook{onStartHook}
-	}
+	updateOpts.OnCreate = expandSagemakerNotebookInstanceLifecycleHooks(d.Get("on_create").(string), d.Get("on_create_content").(string))
+	updateOpts.OnStart = expandSagemakerNotebookInstanceLifecycleHooks(d.Get("on_start").(string), d.Get("on_start_content").(string))
 
 	_, err := conn.UpdateNotebookInstanceLifecycleConfig(updateOpts)
 	if err != nil {
@@ -168,3 +169,48 @@ func resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationDelete(d *schema.
 	}
 	return nil
 }
+
+// expandSagemakerNotebookInstanceLifecycleHooks returns the single lifecycle hook for a script
+// configured either base64 encoded or as plaintext, which is encoded here.
+func expandSagemakerNotebookInstanceLifecycleHooks(encoded, plaintext string) []*sagemaker.NotebookInstanceLifecycleHook {
+	if encoded == "" && plaintext != "" {
+		encoded = base64.StdEncoding.EncodeToString([]byte(plaintext))
+	}
+
+	if encoded == "" {
+		return nil
+	}
+
+	return []*sagemaker.NotebookInstanceLifecycleHook{{Content: aws.String(encoded)}}
+}
+
+// setSagemakerNotebookInstanceLifecycleHooks sets the script argument k from the lifecycle hooks.
+// When the script was configured as plaintext via the "_content" argument it is decoded
+// back into that argument instead, so that either input style reads back without a diff.
+func setSagemakerNotebookInstanceLifecycleHooks(d *schema.ResourceData, k string, hooks []*sagemaker.NotebookInstanceLifecycleHook) error {
+	var encoded string
+
+	if len(hooks) > 0 && hooks[0] != nil {
+		encoded = aws.StringValue(hooks[0].Content)
+	}
+
+	if _, ok := d.GetOk(k + "_content"); ok {
+		plaintext, err := base64.StdEncoding.DecodeString(encoded)
+
+		if err != nil {
+			return fmt.Errorf("error decoding %s: %w", k, err)
+		}
+
+		if err := d.Set(k+"_content", string(plaintext)); err != nil {
+			return err
+		}
+
+		return d.Set(k, "")
+	}
+
+	if len(hooks) == 0 || hooks[0] == nil {
+		return nil
+	}
+
+	return d.Set(k, encoded)
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -134,6 +135,108 @@ func TestAccAWSSagemakerNotebookInstanceLifecycleConfiguration_Update(t *testing
 	})
 }
 
+func TestAccAWSSagemakerNotebookInstanceLifecycleConfiguration_Content(t *testing.T) {
+	var lifecycleConfig sagemaker.DescribeNotebookInstanceLifecycleConfigOutput
+	rName := acctest.RandomWithPrefix(SagemakerNotebookInstanceLifecycleConfigurationResourcePrefix)
+	resourceName := "aws_sagemaker_notebook_instance_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		ErrorCheck:   testAccErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSagemakerNotebookInstanceLifecycleConfigurationConfig_Content(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationHooks(&lifecycleConfig, "echo bla", "echo blub"),
+					resource.TestCheckResourceAttr(resourceName, "on_create", ""),
+					resource.TestCheckResourceAttr(resourceName, "on_create_content", "echo bla"),
+					resource.TestCheckResourceAttr(resourceName, "on_start", ""),
+					resource.TestCheckResourceAttr(resourceName, "on_start_content", "echo blub"),
+				),
+			},
+			{
+				Config:   testAccSagemakerNotebookInstanceLifecycleConfigurationConfig_Content(rName),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccSagemakerNotebookInstanceLifecycleConfigurationConfig_Update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName, &lifecycleConfig),
+					testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationHooks(&lifecycleConfig, "echo bla", "echo blub"),
+					resource.TestCheckResourceAttr(resourceName, "on_create", base64Encode([]byte("echo bla"))),
+					resource.TestCheckResourceAttr(resourceName, "on_create_content", ""),
+					resource.TestCheckResourceAttr(resourceName, "on_start", base64Encode([]byte("echo blub"))),
+					resource.TestCheckResourceAttr(resourceName, "on_start_content", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestExpandSagemakerNotebookInstanceLifecycleHooks(t *testing.T) {
+	script := "#!/bin/bash\necho foo\n"
+	encoded := "IyEvYmluL2Jhc2gKZWNobyBmb28K"
+
+	testCases := []struct {
+		Name      string
+		Encoded   string
+		Plaintext string
+		Expected  []*sagemaker.NotebookInstanceLifecycleHook
+	}{
+		{
+			Name: "unset",
+		},
+		{
+			Name:     "base64 encoded",
+			Encoded:  encoded,
+			Expected: []*sagemaker.NotebookInstanceLifecycleHook{{Content: aws.String(encoded)}},
+		},
+		{
+			Name:      "plaintext",
+			Plaintext: script,
+			Expected:  []*sagemaker.NotebookInstanceLifecycleHook{{Content: aws.String(encoded)}},
+		},
+		{
+			// Plaintext that happens to be valid base64 must still be encoded.
+			Name:      "plaintext valid base64",
+			Plaintext: "echo",
+			Expected:  []*sagemaker.NotebookInstanceLifecycleHook{{Content: aws.String("ZWNobw==")}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := expandSagemakerNotebookInstanceLifecycleHooks(testCase.Encoded, testCase.Plaintext)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationHooks(lifecycleConfig *sagemaker.DescribeNotebookInstanceLifecycleConfigOutput, onCreate, onStart string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got, expected := lifecycleConfig.OnCreate, expandSagemakerNotebookInstanceLifecycleHooks("", onCreate); !reflect.DeepEqual(got, expected) {
+			return fmt.Errorf("OnCreate: got %v, expected %v", got, expected)
+		}
+
+		if got, expected := lifecycleConfig.OnStart, expandSagemakerNotebookInstanceLifecycleHooks("", onStart); !reflect.DeepEqual(got, expected) {
+			return fmt.Errorf("OnStart: got %v, expected %v", got, expected)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSSagemakerNotebookInstanceLifecycleConfigurationExists(resourceName string, lifecycleConfig *sagemaker.DescribeNotebookInstanceLifecycleConfigOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -197,6 +300,16 @@ resource "aws_sagemaker_notebook_instance_lifecycle_configuration" "test" {
 `, rName)
 }
 
+func testAccSagemakerNotebookInstanceLifecycleConfigurationConfig_Content(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sagemaker_notebook_instance_lifecycle_configuration" "test" {
+  name              = %q
+  on_create_content = "echo bla"
+  on_start_content  = "echo blub"
+}
+`, rName)
+}
+
 func testAccSagemakerNotebookInstanceLifecycleConfigurationConfig_Update(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sagemaker_notebook_instance_lifecycle_configuration" "test" {
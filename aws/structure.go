@@ -833,6 +833,24 @@ func expandStringSet(configured *schema.Set) []*string {
 	return expandStringList(configured.List()) // nosemgrep: helper-schema-Set-extraneous-expandStringList-with-List
 }
 
+// expandSingleNestedBlock returns the configuration of a MaxItems: 1 nested block.
+// It returns false if the block is not configured or is empty.
+func expandSingleNestedBlock(v interface{}) (map[string]interface{}, bool) {
+	tfList, ok := v.([]interface{})
+
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil, false
+	}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok || tfMap == nil {
+		return nil, false
+	}
+
+	return tfMap, true
+}
+
 // Takes the result of schema.Set of strings and returns a []*int64
 func expandInt64Set(configured *schema.Set) []*int64 {
 	return expandInt64List(configured.List())
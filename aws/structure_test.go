@@ -571,6 +571,52 @@ func TestExpandStringListEmptyItems(t *testing.T) {
 	}
 }
 
+func TestExpandSingleNestedBlock(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    interface{}
+		expected map[string]interface{}
+		ok       bool
+	}{
+		{
+			name:  "nil",
+			input: nil,
+		},
+		{
+			name:  "empty list",
+			input: []interface{}{},
+		},
+		{
+			name:  "nil element",
+			input: []interface{}{nil},
+		},
+		{
+			name:  "not a list",
+			input: "foo",
+		},
+		{
+			name:     "populated",
+			input:    []interface{}{map[string]interface{}{"key1": "value1"}},
+			expected: map[string]interface{}{"key1": "value1"},
+			ok:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, ok := expandSingleNestedBlock(testCase.input)
+
+			if ok != testCase.ok {
+				t.Errorf("expected ok %t, got %t", testCase.ok, ok)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %#v, got %#v", testCase.expected, got)
+			}
+		})
+	}
+}
+
 func TestExpandParameters(t *testing.T) {
 	expanded := []interface{}{
 		map[string]interface{}{
@@ -1354,7 +1400,7 @@ func TestCognitoUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 					MinLength: aws.String("10"),
 				},
 			},
-			Expected: false,
+			Expected: true,
 		},
 		{
 			Input: &cognitoidentityprovider.SchemaAttributeType{
@@ -1368,7 +1414,7 @@ func TestCognitoUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 					MinLength: aws.String("999"),
 				},
 			},
-			Expected: false,
+			Expected: true,
 		},
 		{
 			Input: &cognitoidentityprovider.SchemaAttributeType{
@@ -1380,6 +1426,33 @@ func TestCognitoUserPoolSchemaAttributeMatchesStandardAttribute(t *testing.T) {
 			},
 			Expected: true,
 		},
+		{
+			Input: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeString),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("address"),
+				Required:               aws.Bool(false),
+				StringAttributeConstraints: &cognitoidentityprovider.StringAttributeConstraintsType{
+					MaxLength: aws.String("4096"),
+					MinLength: aws.String("0"),
+				},
+			},
+			Expected: true,
+		},
+		{
+			Input: &cognitoidentityprovider.SchemaAttributeType{
+				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeNumber),
+				DeveloperOnlyAttribute: aws.Bool(false),
+				Mutable:                aws.Bool(true),
+				Name:                   aws.String("address"),
+				Required:               aws.Bool(false),
+				NumberAttributeConstraints: &cognitoidentityprovider.NumberAttributeConstraintsType{
+					MinValue: aws.String("0"),
+				},
+			},
+			Expected: false,
+		},
 		{
 			Input: &cognitoidentityprovider.SchemaAttributeType{
 				AttributeDataType:      aws.String(cognitoidentityprovider.AttributeDataTypeNumber),
@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -111,6 +112,21 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+	if err := allTags.Validate(); err != nil {
+		return err
+	}
+
+	// Log exactly which keys differ to help troubleshoot unexpected tag diffs.
+	// Tag values may be sensitive, so only redacted values are logged.
+	if diff.Id() != "" {
+		o, _ := diff.GetChange("tags_all")
+		added, removed, changed := keyvaluetags.New(o.(map[string]interface{})).Diff(allTags)
+
+		if len(added) > 0 || len(removed) > 0 || len(changed) > 0 {
+			log.Printf("[DEBUG] Resource (%s) tags_all diff: added: %s, removed: %s, changed: %s", diff.Id(), added.Redacted(), removed.Redacted(), changed.Redacted())
+		}
+	}
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)